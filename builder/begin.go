@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Begin is a builder used for "BEGIN" query.
+type Begin struct {
+	query stmt.Begin
+}
+
+// NewBegin creates a new Begin.
+func NewBegin() Begin {
+	return Begin{
+		query: stmt.NewBegin(),
+	}
+}
+
+// IsolationLevel sets the isolation level of the transaction.
+func (b Begin) IsolationLevel(level types.IsolationLevel) Begin {
+	switch level {
+	case types.ReadUncommitted, types.ReadCommitted, types.RepeatableRead, types.Serializable:
+	default:
+		panic(fmt.Sprintf("loukoum: unknown isolation level %s", level))
+	}
+
+	b.query.IsolationLevel = level
+
+	return b
+}
+
+// ReadOnly disallows writes in the transaction.
+func (b Begin) ReadOnly() Begin {
+	b.query.AccessMode = types.ReadOnly
+
+	return b
+}
+
+// ReadWrite allows writes in the transaction.
+func (b Begin) ReadWrite() Begin {
+	b.query.AccessMode = types.ReadWrite
+
+	return b
+}
+
+// Deferrable makes a serializable and read only transaction wait for a snapshot which cannot fail
+// with a serialization error.
+func (b Begin) Deferrable() Begin {
+	b.query.Deferrable = true
+
+	return b
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Begin) Clone() Begin {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Begin) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Begin) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Begin) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Begin) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Begin is a Builder
+var _ Builder = Begin{}
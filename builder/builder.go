@@ -10,7 +10,7 @@ import (
 	"github.com/ulule/loukoum/v3/types"
 )
 
-// Builder defines a generic methods available for Select, Insert, Update and Delete builders.
+// Builder defines a generic methods available for every builder.
 type Builder interface {
 	// String returns the underlying query as a raw statement.
 	// This function should be used for debugging since it doesn't escape anything and is completely
@@ -49,6 +49,22 @@ func IsDeleteBuilder(builder Builder) bool {
 	return ok
 }
 
+// NamedQueryWithPrefix returns the query of given builder as a named statement, using given prefix for
+// its placeholders.
+func NamedQueryWithPrefix(builder Builder, prefix types.NamedPrefix) (string, map[string]interface{}) {
+	ctx := &types.NamedContext{Prefix: prefix}
+	builder.Statement().Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// DeduplicatedQuery returns the query of given builder as a regular statement, reusing the placeholder of
+// an identical value which is bound several times.
+func DeduplicatedQuery(builder Builder) (string, []interface{}) {
+	ctx := &types.StdContext{Deduplicate: true}
+	builder.Statement().Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
 // ToColumn takes an empty interfaces and returns a Column instance.
 func ToColumn(arg interface{}) stmt.Column {
 	column := stmt.Column{}
@@ -116,6 +132,54 @@ func ToColumns(values []interface{}) []stmt.Column { // nolint: gocyclo
 	return columns
 }
 
+// ToColumnExpressions takes a list of empty interfaces and returns a slice of Expression instance.
+// Strings are handled as column names.
+func ToColumnExpressions(values []interface{}) []stmt.Expression {
+	expressions := make([]stmt.Expression, 0, len(values))
+
+	for i := range values {
+		switch value := values[i].(type) {
+		case string, []string, []stmt.Column:
+			columns := ToColumns([]interface{}{value})
+			for y := range columns {
+				expressions = append(expressions, columns[y])
+			}
+		case stmt.Expression:
+			if value.IsEmpty() {
+				panic("loukoum: given column is undefined")
+			}
+			expressions = append(expressions, value)
+		default:
+			panic(fmt.Sprintf("loukoum: cannot use %T as column", values[i]))
+		}
+	}
+
+	return expressions
+}
+
+// ToConflictTarget takes a list of empty interfaces and returns a ConflictTarget instance.
+func ToConflictTarget(values []interface{}) stmt.ConflictTarget {
+	expressions := ToColumnExpressions(values)
+	columns, ok := toColumnList(expressions)
+	if ok {
+		return stmt.NewConflictTarget(columns)
+	}
+	return stmt.NewConflictTargetExpressions(expressions)
+}
+
+// toColumnList returns given expressions as a slice of Column instance, if they are all columns.
+func toColumnList(expressions []stmt.Expression) ([]stmt.Column, bool) {
+	columns := make([]stmt.Column, 0, len(expressions))
+	for i := range expressions {
+		column, ok := expressions[i].(stmt.Column)
+		if !ok {
+			return nil, false
+		}
+		columns = append(columns, column)
+	}
+	return columns, true
+}
+
 // ToSelectExpressions takes a list of empty interfaces and returns a slice of SelectExpression instance.
 func ToSelectExpressions(values []interface{}) []stmt.SelectExpression { // nolint: gocyclo
 	// If values is a slice, we try to use recursion to obtain a slice of Column.
@@ -200,30 +264,38 @@ func ToTables(values []interface{}) []stmt.Table {
 
 // ToFrom takes an empty interfaces and returns a From instance.
 func ToFrom(args ...interface{}) stmt.From {
-	from := stmt.From{}
+	from := stmt.NewFrom(ToFromItems(args))
+	if from.IsEmpty() {
+		panic("loukoum: given from clause is undefined")
+	}
 
-	tables := make([]stmt.Statement, len(args))
-	for i := range args {
-		switch value := args[i].(type) {
+	return from
+}
+
+// ToFromItems takes a list of empty interfaces and returns a slice of tables or derived tables,
+// usable in a FROM or a USING clause.
+func ToFromItems(values []interface{}) []stmt.Statement {
+	items := make([]stmt.Statement, 0, len(values))
+
+	for i := range values {
+		switch value := values[i].(type) {
 		case string:
-			tables[i] = stmt.NewTable(value)
-		case stmt.From:
-			from = value
+			items = append(items, ToTable(value))
 		case stmt.Table:
-			tables[i] = value
+			items = append(items, ToTable(value))
+		case stmt.DerivedTable:
+			if value.IsEmpty() {
+				panic("loukoum: given derived table requires a source and an alias")
+			}
+			items = append(items, value)
 		case stmt.Raw:
-			tables[i] = value
+			items = append(items, value)
 		default:
-			panic(fmt.Sprintf("loukoum: cannot use %T as from clause", args[i]))
+			panic(fmt.Sprintf("loukoum: cannot use %T as from clause", values[i]))
 		}
 	}
 
-	from = stmt.NewFrom(tables)
-	if from.IsEmpty() {
-		panic("loukoum: given from clause is undefined")
-	}
-
-	return from
+	return items
 }
 
 // ToInto takes an empty interfaces and returns a Into instance.
@@ -320,8 +392,23 @@ func ToInt64(value interface{}) (int64, bool) { // nolint: gocyclo
 	return 0, false
 }
 
+// MergeWith appends given queries to an existing WITH clause.
+func MergeWith(with stmt.With, queries []stmt.WithQuery) stmt.With {
+	list := make([]stmt.WithQuery, 0, len(with.Queries)+len(queries))
+	list = append(list, with.Queries...)
+	for i := range queries {
+		if queries[i].IsEmpty() {
+			panic("loukoum: given with clause is undefined")
+		}
+		list = append(list, queries[i])
+	}
+	return stmt.NewWith(list)
+}
+
 // MergeSet merges new pairs into existing ones (last write wins).
+// Given set is left untouched.
 func MergeSet(set stmt.Set, args []interface{}) stmt.Set {
+	set = stmt.Clone(set)
 	for i := range args {
 		switch value := args[i].(type) {
 		case string, stmt.Column:
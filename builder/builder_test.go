@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ulule/loukoum/v3"
 	"github.com/ulule/loukoum/v3/builder"
 	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
 )
 
 type BuilderTest struct {
@@ -160,3 +164,137 @@ func TestToColumns(t *testing.T) {
 		})
 	}
 }
+
+func TestNamed(t *testing.T) {
+	is := require.New(t)
+
+	query := loukoum.Select("id").
+		From("users").
+		Where(loukoum.Condition("author_id").Equal(loukoum.Named("user_id", 42))).
+		Or(loukoum.Condition("reviewer_id").Equal(loukoum.Named("user_id", 42))).
+		And(loukoum.Condition("status").Equal("published"))
+
+	is.Equal(fmt.Sprint(
+		`SELECT "id" FROM "users" WHERE ((("author_id" = 42) OR ("reviewer_id" = 42)) `,
+		`AND ("status" = 'published'))`,
+	), query.String())
+
+	{
+		sql, args := query.NamedQuery()
+		is.Equal(fmt.Sprint(
+			`SELECT "id" FROM "users" WHERE ((("author_id" = :user_id) OR ("reviewer_id" = :user_id)) `,
+			`AND ("status" = :arg_1))`,
+		), sql)
+		is.Equal(map[string]interface{}{"user_id": 42, "arg_1": "published"}, args)
+	}
+	{
+		sql, args := query.Query()
+		is.Equal(fmt.Sprint(
+			`SELECT "id" FROM "users" WHERE ((("author_id" = $1) OR ("reviewer_id" = $1)) `,
+			`AND ("status" = $2))`,
+		), sql)
+		is.Equal([]interface{}{42, "published"}, args)
+	}
+
+	for prefix, expected := range map[types.NamedPrefix]string{
+		loukoum.ColonPrefix:  `SELECT "id" FROM "users" WHERE ("id" = :user_id)`,
+		loukoum.AtPrefix:     `SELECT "id" FROM "users" WHERE ("id" = @user_id)`,
+		loukoum.DollarPrefix: `SELECT "id" FROM "users" WHERE ("id" = $user_id)`,
+	} {
+		sql, args := builder.NamedQueryWithPrefix(loukoum.Select("id").From("users").
+			Where(loukoum.Condition("id").Equal(loukoum.Named("user_id", 42))), prefix)
+		is.Equal(expected, sql)
+		is.Equal(map[string]interface{}{"user_id": 42}, args)
+	}
+
+	// Generated names are independent of explicit ones.
+	{
+		sql, args := loukoum.Select("id").
+			From("users").
+			Where(loukoum.Condition("id").Equal(loukoum.Named("arg", 1))).
+			And(loukoum.Condition("score").Equal(2)).
+			And(loukoum.Condition("rank").Equal(loukoum.Named("args_1", 3))).
+			NamedQuery()
+		is.Equal(`SELECT "id" FROM "users" WHERE ((("id" = :arg) AND ("score" = :arg_1)) AND ("rank" = :args_1))`, sql)
+		is.Equal(map[string]interface{}{"arg": 1, "arg_1": 2, "args_1": 3}, args)
+	}
+
+	// Slices are bound as array parameters.
+	{
+		query := loukoum.Select("id").
+			From("users").
+			Where(loukoum.Condition("id").Equal(loukoum.Any(loukoum.Named("ids", []int{1, 2})))).
+			And(loukoum.Condition("org_id").Equal(loukoum.Any(loukoum.Named("org_ids", pq.Array([]int64{3})))))
+
+		sql, args := query.NamedQuery()
+		is.Equal(`SELECT "id" FROM "users" WHERE (("id" = ANY(:ids)) AND ("org_id" = ANY(:org_ids)))`, sql)
+		is.Equal(map[string]interface{}{"ids": pq.Array([]int{1, 2}), "org_ids": pq.Array([]int64{3})}, args)
+
+		sql, values := query.Query()
+		is.Equal(`SELECT "id" FROM "users" WHERE (("id" = ANY($1)) AND ("org_id" = ANY($2)))`, sql)
+		is.Equal([]interface{}{pq.Array([]int{1, 2}), pq.Array([]int64{3})}, values)
+	}
+
+	// Conflicting values or names.
+	is.Panics(func() {
+		loukoum.Select("id").
+			From("users").
+			Where(loukoum.Condition("author_id").Equal(loukoum.Named("user_id", 1))).
+			Or(loukoum.Condition("reviewer_id").Equal(loukoum.Named("user_id", 2))).
+			NamedQuery()
+	})
+	is.Panics(func() {
+		loukoum.Select("id").
+			From("users").
+			Where(loukoum.Condition("author_id").Equal(loukoum.Named("user_id", 1))).
+			Or(loukoum.Condition("reviewer_id").Equal(loukoum.Named("user_id", int64(1)))).
+			Query()
+	})
+	is.Panics(func() {
+		loukoum.Named("arg_1", 1)
+	})
+	is.Panics(func() {
+		loukoum.Named("arg_user_id", 1)
+	})
+	is.Panics(func() {
+		builder.NamedQueryWithPrefix(loukoum.Select("id").From("users").
+			Where(loukoum.Condition("id").Equal(loukoum.Named("user_id", 42))), "?")
+	})
+	is.Panics(func() {
+		loukoum.Named("user id", 42)
+	})
+	is.Panics(func() {
+		loukoum.Named("user_id", loukoum.Raw("NOW()"))
+	})
+}
+
+func TestDeduplicatedQuery(t *testing.T) {
+	is := require.New(t)
+
+	query := loukoum.Select("id").
+		From("users").
+		Where(loukoum.Condition("author_id").Equal(42)).
+		Or(loukoum.Condition("reviewer_id").Equal(42)).
+		And(loukoum.Condition("reviewer_id").NotEqual(int64(42))).
+		And(loukoum.Condition("tags").Overlap([]string{"go"})).
+		And(loukoum.Condition("labels").Overlap([]string{"go"})).
+		And(loukoum.Condition("created_at").GreaterThan(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))).
+		And(loukoum.Condition("updated_at").GreaterThan(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))).
+		And(loukoum.Condition("editor_id").Equal(int64(42)))
+
+	sql, args := builder.DeduplicatedQuery(query)
+	is.Equal(fmt.Sprint(
+		`SELECT "id" FROM "users" WHERE (((((((("author_id" = $1) OR ("reviewer_id" = $1)) `,
+		`AND ("reviewer_id" != $2)) AND ("tags" && $3)) AND ("labels" && $3)) `,
+		`AND ("created_at" > $4)) AND ("updated_at" > $4)) AND ("editor_id" = $2))`,
+	), sql)
+	is.Equal([]interface{}{42, int64(42), []string{"go"}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, args)
+
+	sql, args = query.Query()
+	is.Equal(fmt.Sprint(
+		`SELECT "id" FROM "users" WHERE (((((((("author_id" = $1) OR ("reviewer_id" = $2)) `,
+		`AND ("reviewer_id" != $3)) AND ("tags" && $4)) AND ("labels" && $5)) `,
+		`AND ("created_at" > $6)) AND ("updated_at" > $7)) AND ("editor_id" = $8))`,
+	), sql)
+	is.Len(args, 8)
+}
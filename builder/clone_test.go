@@ -0,0 +1,114 @@
+package builder_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+func TestClone(t *testing.T) {
+	is := require.New(t)
+
+	base := loukoum.Select("id").
+		From("users").
+		Join("posts", loukoum.On("users.id", "posts.user_id")).
+		Where(loukoum.Condition("id").In(1, 2)).
+		OrderBy(loukoum.Order("id"))
+	expected := base.String()
+
+	clone := base.Clone()
+	is.Equal(expected, clone.String())
+
+	// Altering the statement of the original builder doesn't alter the clone.
+	query := base.Statement().(stmt.Select)
+	query.Joins[0].Type = types.RightJoin
+	query.OrderBy.Orders[0].Type = types.Desc
+	query.Expressions[0] = loukoum.Column("email")
+
+	is.NotEqual(expected, base.String())
+	is.Equal(expected, clone.String())
+
+	update := loukoum.Update("users").Set(loukoum.Map{"name": "foo"})
+	updated := update.Clone().Set(loukoum.Pair("email", "foo@example.com"))
+	is.Equal(`UPDATE "users" SET "name" = 'foo'`, update.String())
+	is.Equal(`UPDATE "users" SET "email" = 'foo@example.com', "name" = 'foo'`, updated.String())
+}
+
+func TestClone_Concurrent(t *testing.T) {
+	is := require.New(t)
+
+	count := 50
+
+	base := loukoum.Select("id").
+		With(loukoum.With("active", loukoum.Select("id").From("users").Where(loukoum.Condition("active")))).
+		From("users").
+		Join("active", loukoum.On("users.id", "active.id")).
+		Where(loukoum.Condition("deleted_at").IsNull(true)).
+		OrderBy(loukoum.Order("created_at", loukoum.Desc))
+
+	update := loukoum.Update("users").
+		Set(loukoum.Map{"updated_at": loukoum.Raw("NOW()")})
+
+	columns := loukoum.Update("users").
+		Set("first_name", "last_name")
+
+	insert := loukoum.Insert("users").
+		Set(loukoum.Pair("email", "foo@example.com"))
+
+	variants := func(i int) []builder.Builder {
+		table := fmt.Sprintf("table_%d", i)
+		column := fmt.Sprintf("column_%d", i)
+
+		return []builder.Builder{
+			base.
+				Join(table, loukoum.On("users.id", fmt.Sprint(table, ".user_id"))).
+				With(loukoum.With(fmt.Sprint("cte_", i), loukoum.Select(column).From(table))).
+				Where(loukoum.Condition(column).Equal(i)).
+				OrderBy(loukoum.Order(column)),
+			base.Clone().
+				Join(table, loukoum.On("users.id", fmt.Sprint(table, ".user_id")), loukoum.LeftJoin).
+				OrderBy(loukoum.Order(column, loukoum.Desc)).
+				Limit(i + 1),
+			update.
+				Set(loukoum.Pair(column, i)).
+				Set(loukoum.Map{"name": table}),
+			columns.
+				Using(fmt.Sprint("first_", i), fmt.Sprint("last_", i)),
+			insert.
+				OnConflict("email", loukoum.DoUpdate(loukoum.Pair(column, i))),
+		}
+	}
+
+	expected := make([][]string, count)
+	for i := range expected {
+		for _, variant := range variants(i) {
+			expected[i] = append(expected[i], variant.String())
+		}
+	}
+	expectedBase := []string{base.String(), update.String(), insert.String()}
+
+	wg := sync.WaitGroup{}
+	results := make([][]string, count)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for _, variant := range variants(i) {
+				results[i] = append(results[i], variant.String())
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	is.Equal(expected, results)
+	is.Equal(expectedBase, []string{base.String(), update.String(), insert.String()})
+}
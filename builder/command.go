@@ -0,0 +1,61 @@
+package builder
+
+import (
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Command is a builder used for utility statements without options, such as "SAVEPOINT",
+// "RELEASE", "LISTEN" or "NOTIFY".
+type Command struct {
+	query stmt.Statement
+}
+
+// NewCommand creates a new Command using given statement.
+func NewCommand(statement stmt.Statement) Command {
+	if statement == nil || statement.IsEmpty() {
+		panic("loukoum: given statement is undefined")
+	}
+
+	return Command{
+		query: statement,
+	}
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Command) Clone() Command {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Command) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Command) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Command) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Command) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Command is a Builder
+var _ Builder = Command{}
@@ -0,0 +1,226 @@
+package builder_test
+
+import (
+	"testing"
+
+	loukoum "github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+)
+
+func TestBegin(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Default",
+			Builder:   loukoum.Begin(),
+			SameQuery: `BEGIN`,
+		},
+		{
+			Name:      "Isolation level",
+			Builder:   loukoum.Begin().IsolationLevel(loukoum.Serializable).ReadOnly(),
+			SameQuery: `BEGIN ISOLATION LEVEL SERIALIZABLE READ ONLY`,
+		},
+		{
+			Name:      "Deferrable",
+			Builder:   loukoum.Begin().IsolationLevel(loukoum.Serializable).ReadOnly().Deferrable(),
+			SameQuery: `BEGIN ISOLATION LEVEL SERIALIZABLE READ ONLY DEFERRABLE`,
+		},
+		{
+			Name:      "Read write",
+			Builder:   loukoum.Begin().IsolationLevel(loukoum.RepeatableRead).ReadWrite(),
+			SameQuery: `BEGIN ISOLATION LEVEL REPEATABLE READ READ WRITE`,
+		},
+		{
+			Name: "Unknown isolation level",
+			Failure: func() builder.Builder {
+				return loukoum.Begin().IsolationLevel("SNAPSHOT")
+			},
+		},
+	})
+}
+
+func TestCommand(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Commit",
+			Builder:   loukoum.Commit(),
+			SameQuery: `COMMIT`,
+		},
+		{
+			Name:      "Rollback",
+			Builder:   loukoum.Rollback(),
+			SameQuery: `ROLLBACK`,
+		},
+		{
+			Name:      "Savepoint",
+			Builder:   loukoum.Savepoint("before_import"),
+			SameQuery: `SAVEPOINT "before_import"`,
+		},
+		{
+			Name:      "Rollback to",
+			Builder:   loukoum.RollbackTo("before_import"),
+			SameQuery: `ROLLBACK TO SAVEPOINT "before_import"`,
+		},
+		{
+			Name:      "Release",
+			Builder:   loukoum.Release(`my "savepoint"`),
+			SameQuery: `RELEASE SAVEPOINT "my ""savepoint"""`,
+		},
+		{
+			Name: "Set local",
+			Builders: []builder.Builder{
+				loukoum.SetLocal("statement_timeout", "5s"),
+				loukoum.SetLocal("statement_timeout", loukoum.Value("5s")),
+			},
+			SameQuery: `SET LOCAL statement_timeout = E'5s'`,
+		},
+		{
+			Name:      "Set local with number",
+			Builder:   loukoum.SetLocal("lock_timeout", 1000),
+			SameQuery: `SET LOCAL lock_timeout = 1000`,
+		},
+		{
+			Name:      "Set with custom parameter",
+			Builder:   loukoum.SetParameter("app.user_id", "1'; DROP TABLE users; --"),
+			SameQuery: `SET app.user_id = E'1\'; DROP TABLE users; --'`,
+		},
+		{
+			Name:      "Set with default",
+			Builder:   loukoum.SetParameter("search_path", loukoum.Default()),
+			SameQuery: `SET search_path = DEFAULT`,
+		},
+		{
+			Name:      "Listen",
+			Builder:   loukoum.Listen("events"),
+			SameQuery: `LISTEN "events"`,
+		},
+		{
+			Name:      "Unlisten",
+			Builder:   loukoum.Unlisten("events"),
+			SameQuery: `UNLISTEN "events"`,
+		},
+		{
+			Name:      "Unlisten all",
+			Builder:   loukoum.Unlisten(),
+			SameQuery: `UNLISTEN *`,
+		},
+		{
+			Name:      "Notify",
+			Builder:   loukoum.Notify("events"),
+			SameQuery: `NOTIFY "events"`,
+		},
+		{
+			Name:      "Notify with payload",
+			Builder:   loukoum.Notify("events", `{"id": "it's"}`),
+			SameQuery: `NOTIFY "events", E'{"id": "it\'s"}'`,
+		},
+		{
+			Name:       "PgNotify",
+			Builder:    loukoum.PgNotify("events", `{"id": 1}`),
+			String:     `SELECT pg_notify('events', '{"id": 1}')`,
+			Query:      `SELECT pg_notify($1, $2)`,
+			NamedQuery: `SELECT pg_notify(:arg_1, :arg_2)`,
+			Args:       []interface{}{"events", `{"id": 1}`},
+		},
+		{
+			Name: "Set with slice",
+			Failure: func() builder.Builder {
+				return loukoum.SetParameter("search_path", []string{"public', 'pg_temp"})
+			},
+		},
+		{
+			Name: "Set with map",
+			Failure: func() builder.Builder {
+				return loukoum.SetParameter("app.settings", map[string]interface{}{"a')--": 1})
+			},
+		},
+		{
+			Name: "Undefined savepoint",
+			Failure: func() builder.Builder {
+				return loukoum.Savepoint("")
+			},
+		},
+		{
+			Name: "Undefined rollback savepoint",
+			Failure: func() builder.Builder {
+				return loukoum.RollbackTo("")
+			},
+		},
+		{
+			Name: "Invalid parameter name",
+			Failure: func() builder.Builder {
+				return loukoum.SetLocal("statement_timeout = 0; --", 1)
+			},
+		},
+		{
+			Name: "Set with column",
+			Failure: func() builder.Builder {
+				return loukoum.SetLocal("statement_timeout", loukoum.Column("timeout"))
+			},
+		},
+		{
+			Name: "Undefined channel",
+			Failure: func() builder.Builder {
+				return loukoum.Listen("")
+			},
+		},
+	})
+}
+
+func TestLock(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Default",
+			Builder:   loukoum.Lock("users"),
+			SameQuery: `LOCK TABLE "users"`,
+		},
+		{
+			Name:      "Mode",
+			Builder:   loukoum.Lock("users", loukoum.Table("accounts").Only()).Mode(loukoum.ShareRowExclusiveLock),
+			SameQuery: `LOCK TABLE "users", ONLY "accounts" IN SHARE ROW EXCLUSIVE MODE`,
+		},
+		{
+			Name:      "No wait",
+			Builder:   loukoum.Lock("users").Mode(loukoum.AccessExclusiveLock).NoWait(),
+			SameQuery: `LOCK TABLE "users" IN ACCESS EXCLUSIVE MODE NOWAIT`,
+		},
+		{
+			Name: "Without table",
+			Failure: func() builder.Builder {
+				return loukoum.Lock()
+			},
+		},
+		{
+			Name: "With alias",
+			Failure: func() builder.Builder {
+				return loukoum.Lock(loukoum.Table("users").As("u"))
+			},
+		},
+		{
+			Name: "Unknown mode",
+			Failure: func() builder.Builder {
+				return loukoum.Lock("users").Mode("SHARED")
+			},
+		},
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Default",
+			Builder:   loukoum.Truncate("users"),
+			SameQuery: `TRUNCATE "users"`,
+		},
+		{
+			Name:      "Restart identity and cascade",
+			Builder:   loukoum.Truncate("users", "comments").RestartIdentity().Cascade(),
+			SameQuery: `TRUNCATE "users", "comments" RESTART IDENTITY CASCADE`,
+		},
+		{
+			Name: "Without table",
+			Failure: func() builder.Builder {
+				return loukoum.Truncate()
+			},
+		},
+	})
+}
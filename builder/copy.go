@@ -0,0 +1,179 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Copy is a builder used for "COPY" query.
+//
+// Since PostgreSQL doesn't accept bind parameters in a COPY statement, values of a source query are
+// embedded in the query as escaped literals: Query() and NamedQuery() never return arguments.
+type Copy struct {
+	query stmt.Copy
+}
+
+// NewCopyFrom creates a new Copy loading rows in given table from standard input.
+func NewCopyFrom(table interface{}, columns ...interface{}) Copy {
+	return Copy{
+		query: stmt.NewCopyFrom(ToTable(table), toCopyColumns(columns)),
+	}
+}
+
+// NewCopyTo creates a new Copy writing rows on standard output.
+// The source is either a table, with an optional list of columns, or a select query.
+func NewCopyTo(source interface{}, columns ...interface{}) Copy {
+	switch value := source.(type) {
+	case Select:
+		if len(columns) != 0 {
+			panic("loukoum: copy builder cannot use columns with a select query")
+		}
+		if value.query.IsEmpty() {
+			panic("loukoum: given select query is undefined")
+		}
+		return Copy{
+			query: stmt.NewCopyQueryTo(value.query),
+		}
+	case stmt.Select:
+		return NewCopyTo(Select{query: value}, columns...)
+	default:
+		return Copy{
+			query: stmt.NewCopyTo(ToTable(source), toCopyColumns(columns)),
+		}
+	}
+}
+
+// Format sets the data format: text, csv or binary.
+func (b Copy) Format(format types.CopyFormat) Copy {
+	switch format {
+	case types.CopyText, types.CopyCSV, types.CopyBinary:
+	default:
+		panic(fmt.Sprintf("loukoum: unknown copy format %s", format))
+	}
+
+	b.query.Options.Format = format
+	b.checkOptions()
+
+	return b
+}
+
+// Header specifies that the data contains a header line with the names of each column.
+func (b Copy) Header() Copy {
+	b.query.Options.Header = true
+	b.checkOptions()
+
+	return b
+}
+
+// Delimiter sets the character that separates columns within each row.
+func (b Copy) Delimiter(delimiter string) Copy {
+	if len([]rune(delimiter)) != 1 {
+		panic("loukoum: copy delimiter must be a single character")
+	}
+
+	b.query.Options.Delimiter = delimiter
+	b.checkOptions()
+
+	return b
+}
+
+// Null sets the string that represents a null value.
+func (b Copy) Null(null string) Copy {
+	b.query.Options.Null = null
+	b.query.Options.HasNull = true
+	b.checkOptions()
+
+	return b
+}
+
+// Quote sets the quoting character used when a data value is quoted, in csv format.
+func (b Copy) Quote(quote string) Copy {
+	if len([]rune(quote)) != 1 {
+		panic("loukoum: copy quote must be a single character")
+	}
+
+	b.query.Options.Quote = quote
+	b.checkOptions()
+
+	return b
+}
+
+// ForceQuote forces quoting to be used for all non-null values in given columns, in csv format.
+// Without columns, every column is quoted.
+func (b Copy) ForceQuote(columns ...interface{}) Copy {
+	if len(columns) == 0 {
+		b.query.Options.ForceQuoteAll = true
+		b.query.Options.ForceQuote = nil
+	} else {
+		b.query.Options.ForceQuoteAll = false
+		b.query.Options.ForceQuote = ToColumns(columns)
+	}
+	b.checkOptions()
+
+	return b
+}
+
+func (b Copy) checkOptions() {
+	options := b.query.Options
+
+	if options.Format == types.CopyBinary &&
+		(options.Header || options.Delimiter != "" || options.HasNull || options.Quote != "") {
+		panic("loukoum: copy binary format doesn't support header, delimiter, null or quote options")
+	}
+
+	csv := options.Format == types.CopyCSV
+	if !csv && (options.Quote != "" || options.ForceQuoteAll || len(options.ForceQuote) != 0) {
+		panic("loukoum: copy quote options require csv format")
+	}
+
+	if b.query.From && (options.ForceQuoteAll || len(options.ForceQuote) != 0) {
+		panic("loukoum: copy force quote option is only allowed with a copy to statement")
+	}
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Copy) Clone() Copy {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Copy) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Copy) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Copy) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Copy) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Copy is a Builder
+var _ Builder = Copy{}
+
+func toCopyColumns(columns []interface{}) []stmt.Column {
+	if len(columns) == 0 {
+		return nil
+	}
+	return ToColumns(columns)
+}
@@ -0,0 +1,153 @@
+package builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lib/pq"
+
+	loukoum "github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+)
+
+func TestCopy(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "From",
+			Builder:   loukoum.CopyFrom("users"),
+			SameQuery: `COPY "users" FROM STDIN`,
+		},
+		{
+			Name: "From with columns",
+			Builders: []builder.Builder{
+				loukoum.CopyFrom("users", "email", "name"),
+				loukoum.CopyFrom(loukoum.Table("users"), []string{"email", "name"}),
+			},
+			SameQuery: `COPY "users" ("email", "name") FROM STDIN`,
+		},
+		{
+			Name: "From with options",
+			Builder: loukoum.CopyFrom("users", "email", "name").
+				Format(loukoum.CopyCSV).
+				Header().
+				Delimiter(";").
+				Null("").
+				Quote("'"),
+			SameQuery: fmt.Sprint(
+				`COPY "users" ("email", "name") FROM STDIN `,
+				`WITH (FORMAT csv, HEADER true, DELIMITER E';', NULL E'', QUOTE E'\'')`,
+			),
+		},
+		{
+			Name:      "To",
+			Builder:   loukoum.CopyTo("users", "id", "email").Format(loukoum.CopyBinary),
+			SameQuery: `COPY "users" ("id", "email") TO STDOUT WITH (FORMAT binary)`,
+		},
+		{
+			Name: "To with force quote",
+			Builders: []builder.Builder{
+				loukoum.CopyTo("users").Format(loukoum.CopyCSV).ForceQuote("email", "name"),
+				loukoum.CopyTo("users").Format(loukoum.CopyCSV).ForceQuote().ForceQuote("email", "name"),
+			},
+			SameQuery: `COPY "users" TO STDOUT WITH (FORMAT csv, FORCE_QUOTE ("email", "name"))`,
+		},
+		{
+			Name:      "To with force quote on every column",
+			Builder:   loukoum.CopyTo("users").Format(loukoum.CopyCSV).Header().ForceQuote(),
+			SameQuery: `COPY "users" TO STDOUT WITH (FORMAT csv, HEADER true, FORCE_QUOTE *)`,
+		},
+		{
+			Name: "To with query",
+			Builder: loukoum.CopyTo(
+				loukoum.Select("id", "email").
+					From("users").
+					Where(loukoum.Condition("name").Equal("O'Reilly\\")).
+					And(loukoum.Condition("deleted_at").IsNull(true)).
+					And(loukoum.Condition("id").GreaterThan(10)),
+			).Format(loukoum.CopyCSV),
+			SameQuery: fmt.Sprint(
+				`COPY (SELECT "id", "email" FROM "users" `,
+				`WHERE ((("name" = E'O\'Reilly\\') AND ("deleted_at" IS NULL)) AND ("id" > 10))) `,
+				`TO STDOUT WITH (FORMAT csv)`,
+			),
+		},
+		{
+			Name: "To with query and cast",
+			Builders: []builder.Builder{
+				loukoum.CopyTo(loukoum.Select(loukoum.Cast(loukoum.Column("id"), "text").As("id")).From("users")),
+				loukoum.CopyTo(loukoum.Select(loukoum.Cast(loukoum.Column("id"), "text").As("id")).From("users").Statement()),
+			},
+			SameQuery: `COPY (SELECT CAST("id" AS text) AS "id" FROM "users") TO STDOUT`,
+		},
+		{
+			Name: "Query with pq array",
+			Builder: loukoum.CopyTo(loukoum.Select("id").From("t").
+				Where(loukoum.Condition("tags").Contains(pq.StringArray{"x') TO PROGRAM 'id"}))),
+			SameQuery: `COPY (SELECT "id" FROM "t" WHERE ("tags" @> E'{"x\') TO PROGRAM \'id"}')) TO STDOUT`,
+		},
+		{
+			Name: "Query with flat array",
+			Failure: func() builder.Builder {
+				return loukoum.CopyTo(loukoum.Select("id").From("t").
+					Where(loukoum.Condition("tags").Contains(pgtype.FlatArray[string]{"x') TO PROGRAM 'id"})))
+			},
+		},
+		{
+			Name: "Query with map",
+			Failure: func() builder.Builder {
+				return loukoum.CopyTo(loukoum.Select("id").From("t").
+					Where(loukoum.Condition("data").Contains(map[string]interface{}{"a')--": 1})))
+			},
+		},
+		{
+			Name: "Query with slice",
+			Failure: func() builder.Builder {
+				return loukoum.CopyTo(loukoum.Select("id").From("t").
+					Where(loukoum.Condition("tags").Contains([]string{"x') TO PROGRAM 'id"})))
+			},
+		},
+		{
+			Name: "Undefined table",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("")
+			},
+		},
+		{
+			Name: "Query with columns",
+			Failure: func() builder.Builder {
+				return loukoum.CopyTo(loukoum.Select("id").From("users"), "id")
+			},
+		},
+		{
+			Name: "Unknown format",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("users").Format("xml")
+			},
+		},
+		{
+			Name: "Binary with header",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("users").Header().Format(loukoum.CopyBinary)
+			},
+		},
+		{
+			Name: "Quote without csv",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("users").Quote("'")
+			},
+		},
+		{
+			Name: "Force quote with copy from",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("users").Format(loukoum.CopyCSV).ForceQuote()
+			},
+		},
+		{
+			Name: "Invalid delimiter",
+			Failure: func() builder.Builder {
+				return loukoum.CopyFrom("users").Delimiter(";;")
+			},
+		},
+	})
+}
@@ -26,15 +26,23 @@ func (b Delete) From(arg ...interface{}) Delete {
 	return b
 }
 
-// Using adds a ONLY clause to the query.
+// Using adds a USING clause to the query.
 func (b Delete) Using(args ...interface{}) Delete {
 	if !b.query.Using.IsEmpty() {
 		panic("loukoum: delete builder has using clause already defined")
 	}
 
-	tables := ToTables(args)
-	b.query.Using = stmt.NewUsing(tables)
+	b.query.Using = stmt.NewUsing(ToFromItems(args))
+	if b.query.Using.IsEmpty() {
+		panic("loukoum: given using clause is undefined")
+	}
+
+	return b
+}
 
+// With adds WITH clauses.
+func (b Delete) With(args ...stmt.WithQuery) Delete {
+	b.query.With = MergeWith(b.query.With, args)
 	return b
 }
 
@@ -78,6 +86,12 @@ func (b Delete) Comment(comment string) Delete {
 	return b
 }
 
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Delete) Clone() Delete {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
 // String returns the underlying query as a raw statement.
 // This function should be used for debugging since it doesn't escape anything and is completely
 // vulnerable to SQL injection.
@@ -1,6 +1,7 @@
 package builder_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -79,6 +80,40 @@ func TestDelete_Using(t *testing.T) {
 			Builder:   loukoum.Delete("table").Using(loukoum.Table("example"), loukoum.Table("foobar").As("foo")),
 			SameQuery: "DELETE FROM \"table\" USING \"example\", \"foobar\" AS \"foo\"",
 		},
+		{
+			Name: "Subquery",
+			Builder: loukoum.
+				Delete("sessions").
+				Using(loukoum.DerivedTable(
+					loukoum.Select("id").From("users").Where(loukoum.Condition("disabled").Equal(true)),
+					"u",
+				)).
+				Where(loukoum.Condition(loukoum.Column("sessions.user_id")).Equal(loukoum.Column("u.id"))),
+			String: fmt.Sprint(
+				`DELETE FROM "sessions" USING (SELECT "id" FROM "users" WHERE ("disabled" = true)) AS "u" `,
+				`WHERE ("sessions"."user_id" = "u"."id")`,
+			),
+			Query: fmt.Sprint(
+				`DELETE FROM "sessions" USING (SELECT "id" FROM "users" WHERE ("disabled" = $1)) AS "u" `,
+				`WHERE ("sessions"."user_id" = "u"."id")`,
+			),
+			NamedQuery: fmt.Sprint(
+				`DELETE FROM "sessions" USING (SELECT "id" FROM "users" WHERE ("disabled" = :arg_1)) AS "u" `,
+				`WHERE ("sessions"."user_id" = "u"."id")`,
+			),
+			Args: []interface{}{true},
+		},
+		{
+			Name: "Unnest",
+			Builder: loukoum.
+				Delete("tags").
+				Using(loukoum.DerivedTable(loukoum.Func("unnest", []string{"a", "b"}), "t", "name")).
+				Where(loukoum.Condition(loukoum.Column("tags.name")).Equal(loukoum.Column("t.name"))),
+			String:     `DELETE FROM "tags" USING unnest('{"a","b"}') AS "t"("name") WHERE ("tags"."name" = "t"."name")`,
+			Query:      `DELETE FROM "tags" USING unnest($1) AS "t"("name") WHERE ("tags"."name" = "t"."name")`,
+			NamedQuery: `DELETE FROM "tags" USING unnest(:arg_1) AS "t"("name") WHERE ("tags"."name" = "t"."name")`,
+			Args:       []interface{}{[]string{"a", "b"}},
+		},
 	})
 }
 
@@ -123,3 +158,41 @@ func TestDelete_Returning(t *testing.T) {
 		},
 	})
 }
+
+func TestDelete_With(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Using",
+			Builder: loukoum.
+				Delete("comments").
+				With(loukoum.With("archived",
+					loukoum.Select("id").From("threads").Where(loukoum.Condition("archived_at").LessThan("2020-01-01")),
+				)).
+				Using("archived").
+				Where(loukoum.Condition(loukoum.Column("comments.thread_id")).Equal(loukoum.Column("archived.id"))).
+				And(loukoum.Condition("comments.pinned").Equal(false)),
+			String: fmt.Sprint(
+				`WITH archived AS (SELECT "id" FROM "threads" WHERE ("archived_at" < '2020-01-01')) `,
+				`DELETE FROM "comments" USING "archived" `,
+				`WHERE (("comments"."thread_id" = "archived"."id") AND ("comments"."pinned" = false))`,
+			),
+			Query: fmt.Sprint(
+				`WITH archived AS (SELECT "id" FROM "threads" WHERE ("archived_at" < $1)) `,
+				`DELETE FROM "comments" USING "archived" `,
+				`WHERE (("comments"."thread_id" = "archived"."id") AND ("comments"."pinned" = $2))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH archived AS (SELECT "id" FROM "threads" WHERE ("archived_at" < :arg_1)) `,
+				`DELETE FROM "comments" USING "archived" `,
+				`WHERE (("comments"."thread_id" = "archived"."id") AND ("comments"."pinned" = :arg_2))`,
+			),
+			Args: []interface{}{"2020-01-01", false},
+		},
+		{
+			Name: "Undefined",
+			Failure: func() builder.Builder {
+				return loukoum.Delete("comments").With(loukoum.With("archived", loukoum.Raw("")))
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package builder
+
+import (
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Explain is a builder used for "EXPLAIN" query.
+type Explain struct {
+	query stmt.Explain
+}
+
+// NewExplain creates a new Explain of the query generated by given builder.
+// Parameters of the underlying query are preserved.
+func NewExplain(builder Builder, options stmt.ExplainOptions) Explain {
+	if builder == nil {
+		panic("loukoum: given builder is undefined")
+	}
+
+	statement := builder.Statement()
+	if statement == nil || statement.IsEmpty() {
+		panic("loukoum: given builder is undefined")
+	}
+
+	return Explain{
+		query: stmt.NewExplain(statement, options),
+	}
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Explain) Clone() Explain {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Explain) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Explain) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Explain) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Explain) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Explain is a Builder
+var _ Builder = Explain{}
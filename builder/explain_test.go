@@ -0,0 +1,67 @@
+package builder_test
+
+import (
+	"testing"
+
+	loukoum "github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+)
+
+func TestExplain(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Without options",
+			Builder: loukoum.Explain(
+				loukoum.Select("id").From("users").Where(loukoum.Condition("id").Equal(1)),
+				loukoum.ExplainOptions{},
+			),
+			String:     `EXPLAIN SELECT "id" FROM "users" WHERE ("id" = 1)`,
+			Query:      `EXPLAIN SELECT "id" FROM "users" WHERE ("id" = $1)`,
+			NamedQuery: `EXPLAIN SELECT "id" FROM "users" WHERE ("id" = :arg_1)`,
+			Args:       []interface{}{1},
+		},
+		{
+			Name: "With options",
+			Builder: loukoum.Explain(
+				loukoum.Select("id").From("users").Where(loukoum.Condition("email").Equal("foo@example.com")),
+				loukoum.ExplainOptions{
+					Analyze:  true,
+					Buffers:  true,
+					Format:   loukoum.ExplainJSON,
+					Verbose:  true,
+					Settings: true,
+				},
+			),
+			String: `EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON, VERBOSE, SETTINGS) ` +
+				`SELECT "id" FROM "users" WHERE ("email" = 'foo@example.com')`,
+			Query: `EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON, VERBOSE, SETTINGS) ` +
+				`SELECT "id" FROM "users" WHERE ("email" = $1)`,
+			NamedQuery: `EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON, VERBOSE, SETTINGS) ` +
+				`SELECT "id" FROM "users" WHERE ("email" = :arg_1)`,
+			Args: []interface{}{"foo@example.com"},
+		},
+		{
+			Name: "Update",
+			Builder: loukoum.Explain(
+				loukoum.Update("users").Set(loukoum.Pair("name", "foo")).Where(loukoum.Condition("id").Equal(2)),
+				loukoum.ExplainOptions{Format: loukoum.ExplainYAML},
+			),
+			String:     `EXPLAIN (FORMAT YAML) UPDATE "users" SET "name" = 'foo' WHERE ("id" = 2)`,
+			Query:      `EXPLAIN (FORMAT YAML) UPDATE "users" SET "name" = $1 WHERE ("id" = $2)`,
+			NamedQuery: `EXPLAIN (FORMAT YAML) UPDATE "users" SET "name" = :arg_1 WHERE ("id" = :arg_2)`,
+			Args:       []interface{}{"foo", 2},
+		},
+		{
+			Name: "Undefined builder",
+			Failure: func() builder.Builder {
+				return loukoum.Explain(nil, loukoum.ExplainOptions{Analyze: true})
+			},
+		},
+		{
+			Name: "Unknown format",
+			Failure: func() builder.Builder {
+				return loukoum.Explain(loukoum.Select("id").From("users"), loukoum.ExplainOptions{Format: "HTML"})
+			},
+		},
+	})
+}
@@ -38,6 +38,9 @@ func (b Insert) Columns(columns ...interface{}) Insert {
 	if len(b.query.Columns) != 0 {
 		panic("loukoum: insert builder has columns clause already defined")
 	}
+	if b.query.Default {
+		panic("loukoum: insert builder cannot use default values with columns")
+	}
 
 	b.query.Columns = ToColumns(columns)
 
@@ -46,7 +49,7 @@ func (b Insert) Columns(columns ...interface{}) Insert {
 
 // Values sets the query values.
 func (b Insert) Values(values ...interface{}) Insert {
-	if !b.query.Values.IsEmpty() {
+	if b.hasSource() {
 		panic("loukoum: insert builder has values clause already defined")
 	}
 
@@ -55,6 +58,56 @@ func (b Insert) Values(values ...interface{}) Insert {
 	return b
 }
 
+// With adds WITH clauses.
+func (b Insert) With(args ...stmt.WithQuery) Insert {
+	b.query.With = MergeWith(b.query.With, args)
+	return b
+}
+
+// Select uses the rows returned by given query as values.
+func (b Insert) Select(query Select) Insert {
+	if b.hasSource() {
+		panic("loukoum: insert builder has values clause already defined")
+	}
+	if query.query.IsEmpty() {
+		panic("loukoum: given select query is undefined")
+	}
+
+	b.query.Select = query.query
+
+	return b
+}
+
+// DefaultValues fills every column with its default value, using a DEFAULT VALUES clause.
+func (b Insert) DefaultValues() Insert {
+	if b.hasSource() {
+		panic("loukoum: insert builder has values clause already defined")
+	}
+	if len(b.query.Columns) != 0 {
+		panic("loukoum: insert builder cannot use default values with columns")
+	}
+
+	b.query.Default = true
+
+	return b
+}
+
+// OverridingSystemValue uses given values for identity columns, even if they're defined as GENERATED ALWAYS.
+func (b Insert) OverridingSystemValue() Insert {
+	b.query.Overriding = types.OverridingSystemValue
+	return b
+}
+
+// OverridingUserValue ignores given values for identity columns defined as GENERATED BY DEFAULT.
+func (b Insert) OverridingUserValue() Insert {
+	b.query.Overriding = types.OverridingUserValue
+	return b
+}
+
+func (b Insert) hasSource() bool {
+	return !b.query.Values.IsEmpty() || !b.query.Select.IsEmpty() || b.query.Default
+}
+
 // Returning builds the RETURNING clause.
 func (b Insert) Returning(values ...interface{}) Insert {
 	if !b.query.Returning.IsEmpty() {
@@ -83,17 +136,30 @@ func (b Insert) OnConflict(args ...interface{}) Insert {
 		panic("loukoum: on conflict clause requires arguments")
 	}
 
+	target := b.query.OnConflict.Target
+	columns := []interface{}{}
+
 	for i := range args {
 		switch value := args[i].(type) {
 		case string, stmt.Column:
-			b.query.OnConflict.Target.Columns = append(b.query.OnConflict.Target.Columns, ToColumn(value))
+			columns = append(columns, value)
+		case stmt.ConflictTarget:
+			if !target.IsEmpty() || len(columns) != 0 {
+				panic("loukoum: on conflict clause has target already defined")
+			}
+			target = value
+		case stmt.Expression:
+			columns = append(columns, value)
 		case stmt.ConflictNoAction:
+			b.query.OnConflict.Target = withConflictColumns(target, columns)
 			b.query.OnConflict.Action = value
 			return b
 		case stmt.ConflictUpdateAction:
-			if b.query.OnConflict.Target.IsEmpty() {
+			target = withConflictColumns(target, columns)
+			if target.IsEmpty() {
 				panic("loukoum: on conflict update clause requires at least one target")
 			}
+			b.query.OnConflict.Target = target
 			b.query.OnConflict.Action = value
 			return b
 		default:
@@ -109,7 +175,7 @@ func (b Insert) Set(args ...interface{}) Insert {
 	if len(b.query.Columns) != 0 {
 		panic("loukoum: insert builder has columns clause already defined")
 	}
-	if !b.query.Values.IsEmpty() {
+	if b.hasSource() {
 		panic("loukoum: insert builder has values clause already defined")
 	}
 
@@ -125,6 +191,12 @@ func (b Insert) Set(args ...interface{}) Insert {
 	return b
 }
 
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Insert) Clone() Insert {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
 // String returns the underlying query as a raw statement.
 // This function should be used for debugging since it doesn't escape anything and is completely
 // vulnerable to SQL injection.
@@ -156,3 +228,26 @@ func (b Insert) Statement() stmt.Statement {
 
 // Ensure that Insert is a Builder
 var _ Builder = Insert{}
+
+// withConflictColumns returns a copy of given target with given index columns and expressions, appended
+// after the existing ones, in their order.
+func withConflictColumns(target stmt.ConflictTarget, args []interface{}) stmt.ConflictTarget {
+	if len(args) == 0 {
+		return target
+	}
+	if target.Constraint != "" {
+		panic("loukoum: on conflict clause has constraint target already defined")
+	}
+
+	items := make([]interface{}, 0, len(target.Columns)+len(target.Expressions)+len(args))
+	for i := range target.Columns {
+		items = append(items, target.Columns[i])
+	}
+	for i := range target.Expressions {
+		items = append(items, target.Expressions[i])
+	}
+
+	merged := ToConflictTarget(append(items, args...))
+	merged.Condition = target.Condition
+	return merged
+}
@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
 
 	loukoum "github.com/ulule/loukoum/v3"
 	"github.com/ulule/loukoum/v3/builder"
+	"github.com/ulule/loukoum/v3/stmt"
 )
 
 func TestInsert_Columns(t *testing.T) {
@@ -343,6 +345,198 @@ func TestInsert_OnConflict(t *testing.T) {
 	})
 }
 
+func TestInsert_OnConflict_Target(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Constraint",
+			Builder: loukoum.
+				Insert("users").
+				Set(loukoum.Pair("email", "tech@ulule.com"), loukoum.Pair("name", "Ulule")).
+				OnConflict(loukoum.OnConstraint("users_email_key"), loukoum.DoNothing()),
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ('tech@ulule.com', 'Ulule') `,
+				`ON CONFLICT ON CONSTRAINT "users_email_key" DO NOTHING`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ($1, $2) `,
+				`ON CONFLICT ON CONSTRAINT "users_email_key" DO NOTHING`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES (:arg_1, :arg_2) `,
+				`ON CONFLICT ON CONSTRAINT "users_email_key" DO NOTHING`,
+			),
+			Args: []interface{}{"tech@ulule.com", "Ulule"},
+		},
+		{
+			Name: "Partial index",
+			Builder: loukoum.
+				Insert("users").
+				Set(loukoum.Pair("email", "tech@ulule.com"), loukoum.Pair("name", "Ulule")).
+				OnConflict(
+					loukoum.ConflictTarget("email").Where(loukoum.Condition("deleted_at").IsNull(true)),
+					loukoum.DoUpdate(loukoum.Pair("name", loukoum.Excluded("name"))),
+				),
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ('tech@ulule.com', 'Ulule') `,
+				`ON CONFLICT ("email") WHERE ("deleted_at" IS NULL) DO UPDATE SET "name" = "excluded"."name"`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ($1, $2) `,
+				`ON CONFLICT ("email") WHERE ("deleted_at" IS NULL) DO UPDATE SET "name" = "excluded"."name"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES (:arg_1, :arg_2) `,
+				`ON CONFLICT ("email") WHERE ("deleted_at" IS NULL) DO UPDATE SET "name" = "excluded"."name"`,
+			),
+			Args: []interface{}{"tech@ulule.com", "Ulule"},
+		},
+		{
+			Name: "Expressions",
+			Builder: loukoum.
+				Insert("users").
+				Set(loukoum.Pair("email", "tech@ulule.com"), loukoum.Pair("name", "Ulule")).
+				OnConflict(
+					loukoum.Func("lower", loukoum.Column("email")),
+					loukoum.Add(loukoum.Column("tenant_id"), 0),
+					loukoum.DoNothing(),
+				),
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ('tech@ulule.com', 'Ulule') `,
+				`ON CONFLICT (lower("email"), (("tenant_id" + 0))) DO NOTHING`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES ($1, $2) `,
+				`ON CONFLICT (lower("email"), (("tenant_id" + $3))) DO NOTHING`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("email", "name") VALUES (:arg_1, :arg_2) `,
+				`ON CONFLICT (lower("email"), (("tenant_id" + :arg_3))) DO NOTHING`,
+			),
+			Args: []interface{}{"tech@ulule.com", "Ulule", 0},
+		},
+		{
+			Name: "Columns and expressions",
+			Builders: []builder.Builder{
+				loukoum.
+					Insert("users").
+					Set(loukoum.Pair("email", "tech@ulule.com")).
+					OnConflict("tenant_id", loukoum.Func("lower", loukoum.Column("email")), loukoum.DoNothing()),
+				loukoum.
+					Insert("users").
+					Set(loukoum.Pair("email", "tech@ulule.com")).
+					OnConflict(
+						stmt.NewConflictTarget([]stmt.Column{stmt.NewColumn("tenant_id")}),
+						loukoum.Func("lower", loukoum.Column("email")),
+						loukoum.DoNothing(),
+					),
+			},
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES ('tech@ulule.com') `,
+				`ON CONFLICT ("tenant_id", lower("email")) DO NOTHING`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES ($1) `,
+				`ON CONFLICT ("tenant_id", lower("email")) DO NOTHING`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES (:arg_1) `,
+				`ON CONFLICT ("tenant_id", lower("email")) DO NOTHING`,
+			),
+			Args: []interface{}{"tech@ulule.com"},
+		},
+		{
+			Name: "Expressions and columns",
+			Builders: []builder.Builder{
+				loukoum.
+					Insert("users").
+					Set(loukoum.Pair("email", "tech@ulule.com")).
+					OnConflict(loukoum.Func("lower", loukoum.Column("email")), "tenant_id", loukoum.DoNothing()),
+				loukoum.
+					Insert("users").
+					Set(loukoum.Pair("email", "tech@ulule.com")).
+					OnConflict(
+						stmt.NewConflictTargetExpressions([]stmt.Expression{
+							loukoum.Func("lower", loukoum.Column("email")),
+						}),
+						"tenant_id",
+						loukoum.DoNothing(),
+					),
+			},
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES ('tech@ulule.com') `,
+				`ON CONFLICT (lower("email"), "tenant_id") DO NOTHING`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES ($1) `,
+				`ON CONFLICT (lower("email"), "tenant_id") DO NOTHING`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("email") VALUES (:arg_1) `,
+				`ON CONFLICT (lower("email"), "tenant_id") DO NOTHING`,
+			),
+			Args: []interface{}{"tech@ulule.com"},
+		},
+		{
+			Name: "Do update with condition",
+			Builder: loukoum.
+				Insert("counters").
+				Set(loukoum.Pair("name", "visits"), loukoum.Pair("value", 1)).
+				OnConflict("name", loukoum.DoUpdate(
+					loukoum.Pair("value", loukoum.Add(loukoum.Column("counters.value"), loukoum.Excluded("value"))),
+				).Where(loukoum.Condition("counters.locked").Equal(false))),
+			String: fmt.Sprint(
+				`INSERT INTO "counters" ("name", "value") VALUES ('visits', 1) ON CONFLICT ("name") `,
+				`DO UPDATE SET "value" = ("counters"."value" + "excluded"."value") WHERE ("counters"."locked" = false)`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "counters" ("name", "value") VALUES ($1, $2) ON CONFLICT ("name") `,
+				`DO UPDATE SET "value" = ("counters"."value" + "excluded"."value") WHERE ("counters"."locked" = $3)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "counters" ("name", "value") VALUES (:arg_1, :arg_2) ON CONFLICT ("name") `,
+				`DO UPDATE SET "value" = ("counters"."value" + "excluded"."value") WHERE ("counters"."locked" = :arg_3)`,
+			),
+			Args: []interface{}{"visits", 1, false},
+		},
+		{
+			Name: "Do update excluded",
+			Builder: loukoum.
+				Insert("users").
+				Columns("id", "email", "name", "created_at").
+				Values(1, "tech@ulule.com", "Ulule", loukoum.Raw("NOW()")).
+				OnConflict("id", loukoum.DoUpdateExcluded("id", "created_at")),
+			String: fmt.Sprint(
+				`INSERT INTO "users" ("id", "email", "name", "created_at") VALUES (1, 'tech@ulule.com', 'Ulule', NOW()) `,
+				`ON CONFLICT ("id") DO UPDATE SET "email" = "excluded"."email", "name" = "excluded"."name"`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "users" ("id", "email", "name", "created_at") VALUES ($1, $2, $3, NOW()) `,
+				`ON CONFLICT ("id") DO UPDATE SET "email" = "excluded"."email", "name" = "excluded"."name"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "users" ("id", "email", "name", "created_at") VALUES (:arg_1, :arg_2, :arg_3, NOW()) `,
+				`ON CONFLICT ("id") DO UPDATE SET "email" = "excluded"."email", "name" = "excluded"."name"`,
+			),
+			Args: []interface{}{1, "tech@ulule.com", "Ulule"},
+		},
+		{
+			Name: "Do update excluded without columns",
+			Failure: func() builder.Builder {
+				return loukoum.Insert("counters").DefaultValues().OnConflict("id", loukoum.DoUpdateExcluded())
+			},
+		},
+		{
+			Name: "Constraint and columns",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Insert("users").
+					Set(loukoum.Pair("email", "tech@ulule.com")).
+					OnConflict(loukoum.OnConstraint("users_email_key"), "email", loukoum.DoNothing())
+			},
+		},
+	})
+}
+
 func TestInsert_Returning(t *testing.T) {
 	RunBuilderTests(t, []BuilderTest{
 		{
@@ -580,3 +774,229 @@ func TestInsert_Set(t *testing.T) {
 		},
 	})
 }
+
+func TestInsert_ValuesSubquery(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Row",
+			Builder: loukoum.
+				Insert("subscriptions").
+				Columns("user_id", "plan_id").
+				Values(42, loukoum.Select("id").From("plans").Where(loukoum.Condition("slug").Equal("pro"))),
+			String: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES (42, (SELECT "id" FROM "plans" WHERE ("slug" = 'pro')))`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES ($1, (SELECT "id" FROM "plans" WHERE ("slug" = $2)))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES (:arg_1, (SELECT "id" FROM "plans" WHERE ("slug" = :arg_2)))`,
+			),
+			Args: []interface{}{42, "pro"},
+		},
+		{
+			Name: "Rows",
+			Builder: loukoum.
+				Insert("subscriptions").
+				Columns("user_id", "plan_id").
+				Values([][]interface{}{
+					{1, loukoum.Select("id").From("plans").Where(loukoum.Condition("slug").Equal("free"))},
+					{2, loukoum.Select("id").From("plans").Where(loukoum.Condition("slug").Equal("pro"))},
+				}),
+			String: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES (1, (SELECT "id" FROM "plans" WHERE ("slug" = 'free'))), `,
+				`(2, (SELECT "id" FROM "plans" WHERE ("slug" = 'pro')))`,
+			),
+			Query: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES ($1, (SELECT "id" FROM "plans" WHERE ("slug" = $2))), `,
+				`($3, (SELECT "id" FROM "plans" WHERE ("slug" = $4)))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") `,
+				`VALUES (:arg_1, (SELECT "id" FROM "plans" WHERE ("slug" = :arg_2))), `,
+				`(:arg_3, (SELECT "id" FROM "plans" WHERE ("slug" = :arg_4)))`,
+			),
+			Args: []interface{}{1, "free", 2, "pro"},
+		},
+	})
+}
+
+func TestInsert_With(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Scalar subquery",
+			Builder: loukoum.
+				Insert("subscriptions").
+				With(loukoum.With("plan", loukoum.Select("id").From("plans").Where(loukoum.Condition("slug").Equal("pro")))).
+				Columns("user_id", "plan_id").
+				Values(42, loukoum.Select("id").From("plan")).
+				Returning("id"),
+			String: fmt.Sprint(
+				`WITH plan AS (SELECT "id" FROM "plans" WHERE ("slug" = 'pro')) `,
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") VALUES (42, (SELECT "id" FROM "plan")) RETURNING "id"`,
+			),
+			Query: fmt.Sprint(
+				`WITH plan AS (SELECT "id" FROM "plans" WHERE ("slug" = $1)) `,
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") VALUES ($2, (SELECT "id" FROM "plan")) RETURNING "id"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH plan AS (SELECT "id" FROM "plans" WHERE ("slug" = :arg_1)) `,
+				`INSERT INTO "subscriptions" ("user_id", "plan_id") VALUES (:arg_2, (SELECT "id" FROM "plan")) RETURNING "id"`,
+			),
+			Args: []interface{}{"pro", 42},
+		},
+		{
+			Name: "Data-modifying",
+			Builder: loukoum.
+				Insert("audit_logs").
+				With(loukoum.With("archived",
+					loukoum.Update("comments").
+						Set(loukoum.Pair("archived", true)).
+						Where(loukoum.Condition("thread_id").Equal(7)).
+						Returning("id"),
+				)).
+				Set(
+					loukoum.Pair("action", "archive"),
+					loukoum.Pair("total", loukoum.Select(loukoum.Count("*")).From("archived")),
+				),
+			String: fmt.Sprint(
+				`WITH archived AS (UPDATE "comments" SET "archived" = true WHERE ("thread_id" = 7) RETURNING "id") `,
+				`INSERT INTO "audit_logs" ("action", "total") VALUES ('archive', (SELECT COUNT(*) FROM "archived"))`,
+			),
+			Query: fmt.Sprint(
+				`WITH archived AS (UPDATE "comments" SET "archived" = $1 WHERE ("thread_id" = $2) RETURNING "id") `,
+				`INSERT INTO "audit_logs" ("action", "total") VALUES ($3, (SELECT COUNT(*) FROM "archived"))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH archived AS (UPDATE "comments" SET "archived" = :arg_1 WHERE ("thread_id" = :arg_2) RETURNING "id") `,
+				`INSERT INTO "audit_logs" ("action", "total") VALUES (:arg_3, (SELECT COUNT(*) FROM "archived"))`,
+			),
+			Args: []interface{}{true, 7, "archive"},
+		},
+	})
+}
+
+func TestInsert_Select(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Simple",
+			Builder: loukoum.
+				Insert("archive").
+				Columns("id", "body").
+				Select(loukoum.Select("id", "body").From("comments").Where(loukoum.Condition("deleted").Equal(true))),
+			String:     `INSERT INTO "archive" ("id", "body") SELECT "id", "body" FROM "comments" WHERE ("deleted" = true)`,
+			Query:      `INSERT INTO "archive" ("id", "body") SELECT "id", "body" FROM "comments" WHERE ("deleted" = $1)`,
+			NamedQuery: `INSERT INTO "archive" ("id", "body") SELECT "id", "body" FROM "comments" WHERE ("deleted" = :arg_1)`,
+			Args:       []interface{}{true},
+		},
+		{
+			Name: "With",
+			Builder: loukoum.
+				Insert("archive").
+				With(loukoum.With("removed",
+					loukoum.Delete("comments").Where(loukoum.Condition("thread_id").Equal(7)).Returning("id", "body"),
+				)).
+				Columns("id", "body", "reason").
+				Select(loukoum.Select("id", "body", loukoum.Cast(loukoum.Value("thread closed"), "text")).From("removed")).
+				OnConflict("id", loukoum.DoNothing()).
+				Returning("id"),
+			String: fmt.Sprint(
+				`WITH removed AS (DELETE FROM "comments" WHERE ("thread_id" = 7) RETURNING "id", "body") `,
+				`INSERT INTO "archive" ("id", "body", "reason") SELECT "id", "body", CAST('thread closed' AS text) FROM "removed" `,
+				`ON CONFLICT ("id") DO NOTHING RETURNING "id"`,
+			),
+			Query: fmt.Sprint(
+				`WITH removed AS (DELETE FROM "comments" WHERE ("thread_id" = $1) RETURNING "id", "body") `,
+				`INSERT INTO "archive" ("id", "body", "reason") SELECT "id", "body", CAST($2 AS text) FROM "removed" `,
+				`ON CONFLICT ("id") DO NOTHING RETURNING "id"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH removed AS (DELETE FROM "comments" WHERE ("thread_id" = :arg_1) RETURNING "id", "body") `,
+				`INSERT INTO "archive" ("id", "body", "reason") SELECT "id", "body", CAST(:arg_2 AS text) FROM "removed" `,
+				`ON CONFLICT ("id") DO NOTHING RETURNING "id"`,
+			),
+			Args: []interface{}{7, "thread closed"},
+		},
+		{
+			Name: "Select and values",
+			Failure: func() builder.Builder {
+				return loukoum.Insert("archive").Columns("id").Values(1).Select(loukoum.Select("id").From("comments"))
+			},
+		},
+	})
+}
+
+func TestInsert_Default(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Default values",
+			Builder:   loukoum.Insert("counters").DefaultValues().Returning("id"),
+			SameQuery: `INSERT INTO "counters" DEFAULT VALUES RETURNING "id"`,
+		},
+		{
+			Name:       "Values",
+			Builder:    loukoum.Insert("users").Columns("id", "email").Values(loukoum.Default(), "tech@ulule.com"),
+			String:     `INSERT INTO "users" ("id", "email") VALUES (DEFAULT, 'tech@ulule.com')`,
+			Query:      `INSERT INTO "users" ("id", "email") VALUES (DEFAULT, $1)`,
+			NamedQuery: `INSERT INTO "users" ("id", "email") VALUES (DEFAULT, :arg_1)`,
+			Args:       []interface{}{"tech@ulule.com"},
+		},
+		{
+			Name: "Set",
+			Builder: loukoum.
+				Insert("users").
+				Set(loukoum.Pair("email", "tech@ulule.com"), loukoum.Pair("created_at", loukoum.Default())),
+			String:     `INSERT INTO "users" ("created_at", "email") VALUES (DEFAULT, 'tech@ulule.com')`,
+			Query:      `INSERT INTO "users" ("created_at", "email") VALUES (DEFAULT, $1)`,
+			NamedQuery: `INSERT INTO "users" ("created_at", "email") VALUES (DEFAULT, :arg_1)`,
+			Args:       []interface{}{"tech@ulule.com"},
+		},
+		{
+			Name: "Overriding system value",
+			Builder: loukoum.
+				Insert("users").
+				Columns("id", "email").
+				OverridingSystemValue().
+				Values(42, "tech@ulule.com"),
+			String:     `INSERT INTO "users" ("id", "email") OVERRIDING SYSTEM VALUE VALUES (42, 'tech@ulule.com')`,
+			Query:      `INSERT INTO "users" ("id", "email") OVERRIDING SYSTEM VALUE VALUES ($1, $2)`,
+			NamedQuery: `INSERT INTO "users" ("id", "email") OVERRIDING SYSTEM VALUE VALUES (:arg_1, :arg_2)`,
+			Args:       []interface{}{42, "tech@ulule.com"},
+		},
+		{
+			Name: "Overriding user value",
+			Builder: loukoum.
+				Insert("users").
+				Columns("id", "email").
+				OverridingUserValue().
+				Select(loukoum.Select("id", "email").From("legacy_users")),
+			SameQuery: `INSERT INTO "users" ("id", "email") OVERRIDING USER VALUE SELECT "id", "email" FROM "legacy_users"`,
+		},
+		{
+			Name: "Default values with columns",
+			Failure: func() builder.Builder {
+				return loukoum.Insert("users").Columns("id").DefaultValues()
+			},
+		},
+	})
+}
+
+func TestInsert_OnConflict_Columns(t *testing.T) {
+	is := require.New(t)
+
+	query := loukoum.Insert("users").
+		Set(loukoum.Pair("email", "tech@ulule.com")).
+		OnConflict("email", loukoum.DoNothing()).
+		Statement().(stmt.Insert)
+	is.Equal([]stmt.Column{stmt.NewColumn("email")}, query.OnConflict.Target.Columns)
+	is.Empty(query.OnConflict.Target.Expressions)
+
+	target := loukoum.ConflictTarget(loukoum.Func("lower", loukoum.Column("email")))
+	is.Empty(target.Columns)
+	is.Len(target.Expressions, 1)
+}
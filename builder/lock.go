@@ -0,0 +1,94 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Lock is a builder used for "LOCK TABLE" query.
+type Lock struct {
+	query stmt.Lock
+}
+
+// NewLock creates a new Lock using given tables.
+func NewLock(tables ...interface{}) Lock {
+	return Lock{
+		query: stmt.NewLock(toUtilityTables(tables)),
+	}
+}
+
+// Mode sets the lock mode. Without mode, ACCESS EXCLUSIVE is used by PostgreSQL.
+func (b Lock) Mode(mode types.LockMode) Lock {
+	switch mode {
+	case types.AccessShareLock, types.RowShareLock, types.RowExclusiveLock, types.ShareUpdateExclusiveLock,
+		types.ShareLock, types.ShareRowExclusiveLock, types.ExclusiveLock, types.AccessExclusiveLock:
+	default:
+		panic(fmt.Sprintf("loukoum: unknown lock mode %s", mode))
+	}
+
+	b.query.Mode = mode
+
+	return b
+}
+
+// NoWait fails immediately if the lock cannot be acquired, instead of waiting.
+func (b Lock) NoWait() Lock {
+	b.query.NoWait = true
+
+	return b
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Lock) Clone() Lock {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Lock) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Lock) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Lock) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Lock) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Lock is a Builder
+var _ Builder = Lock{}
+
+func toUtilityTables(values []interface{}) []stmt.Table {
+	if len(values) == 0 {
+		panic("loukoum: requires at least one table")
+	}
+
+	tables := ToTables(values)
+	for i := range tables {
+		if tables[i].Alias != "" {
+			panic("loukoum: cannot use an alias on a locked or truncated table")
+		}
+	}
+
+	return tables
+}
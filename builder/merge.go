@@ -0,0 +1,156 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Merge is a builder used for "MERGE" query.
+type Merge struct {
+	query stmt.Merge
+}
+
+// NewMerge creates a new Merge.
+func NewMerge() Merge {
+	return Merge{
+		query: stmt.NewMerge(),
+	}
+}
+
+// Into sets the target table of the query.
+func (b Merge) Into(into interface{}) Merge {
+	if !b.query.Into.IsEmpty() {
+		panic("loukoum: merge builder has into clause already defined")
+	}
+
+	b.query.Into = ToTable(into)
+
+	return b
+}
+
+// Using sets the data source of the query: either a table or a derived table.
+func (b Merge) Using(source interface{}) Merge {
+	if b.query.Using != nil {
+		panic("loukoum: merge builder has using clause already defined")
+	}
+
+	b.query.Using = ToFromItems([]interface{}{source})[0]
+
+	return b
+}
+
+// On sets the join condition between the target and the data source.
+func (b Merge) On(condition stmt.Expression) Merge {
+	if b.query.Condition != nil {
+		panic("loukoum: merge builder has on clause already defined")
+	}
+	if condition == nil || condition.IsEmpty() {
+		panic("loukoum: given on clause is undefined")
+	}
+
+	b.query.Condition = condition
+
+	return b
+}
+
+// WhenMatched adds a WHEN MATCHED clause, with an optional extra condition.
+func (b Merge) WhenMatched(action stmt.MergeAction, conditions ...stmt.Expression) Merge {
+	if _, ok := action.(stmt.MergeInsertAction); ok {
+		panic("loukoum: merge insert action requires a when not matched clause")
+	}
+	return b.when(true, action, conditions)
+}
+
+// WhenNotMatched adds a WHEN NOT MATCHED clause, with an optional extra condition.
+func (b Merge) WhenNotMatched(action stmt.MergeAction, conditions ...stmt.Expression) Merge {
+	switch action.(type) {
+	case stmt.MergeUpdateAction, stmt.MergeDeleteAction:
+		panic(fmt.Sprintf("loukoum: cannot use %T with a when not matched clause", action))
+	}
+	return b.when(false, action, conditions)
+}
+
+func (b Merge) when(matched bool, action stmt.MergeAction, conditions []stmt.Expression) Merge {
+	if action == nil || action.IsEmpty() {
+		panic("loukoum: given merge action is undefined")
+	}
+
+	var condition stmt.Expression
+	for i := range conditions {
+		if condition == nil {
+			condition = conditions[i]
+		} else {
+			condition = stmt.NewInfixExpression(condition, stmt.NewAndOperator(), conditions[i])
+		}
+	}
+
+	whens := make([]stmt.MergeWhen, len(b.query.Whens), len(b.query.Whens)+1)
+	copy(whens, b.query.Whens)
+	b.query.Whens = append(whens, stmt.NewMergeWhen(matched, condition, action))
+
+	return b
+}
+
+// With adds WITH clauses.
+func (b Merge) With(args ...stmt.WithQuery) Merge {
+	b.query.With = MergeWith(b.query.With, args)
+	return b
+}
+
+// Returning builds the RETURNING clause.
+func (b Merge) Returning(values ...interface{}) Merge {
+	if !b.query.Returning.IsEmpty() {
+		panic("loukoum: merge builder has returning clause already defined")
+	}
+
+	b.query.Returning = stmt.NewReturning(ToSelectExpressions(values))
+
+	return b
+}
+
+// Comment adds comment to the query.
+func (b Merge) Comment(comment string) Merge {
+	b.query.Comment = stmt.NewComment(comment)
+
+	return b
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Merge) Clone() Merge {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Merge) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Merge) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Merge) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Merge) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Merge is a Builder
+var _ Builder = Merge{}
@@ -0,0 +1,145 @@
+package builder_test
+
+import (
+	"fmt"
+	"testing"
+
+	loukoum "github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+)
+
+func TestMerge(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Table",
+			Builder: loukoum.
+				Merge(loukoum.Table("products").As("p")).
+				Using(loukoum.Table("imports").As("i")).
+				On(loukoum.Condition(loukoum.Column("p.sku")).Equal(loukoum.Column("i.sku"))).
+				WhenMatched(loukoum.MergeUpdate(loukoum.Pair("price", loukoum.Column("i.price")))).
+				WhenNotMatched(loukoum.MergeInsert(
+					loukoum.Pair("sku", loukoum.Column("i.sku")),
+					loukoum.Pair("price", loukoum.Column("i.price")),
+				)),
+			SameQuery: fmt.Sprint(
+				`MERGE INTO "products" AS "p" USING "imports" AS "i" ON ("p"."sku" = "i"."sku") `,
+				`WHEN MATCHED THEN UPDATE SET "price" = "i"."price" `,
+				`WHEN NOT MATCHED THEN INSERT ("price", "sku") VALUES ("i"."price", "i"."sku")`,
+			),
+		},
+		{
+			Name: "Conditions",
+			Builder: loukoum.
+				Merge("accounts").
+				Using(loukoum.DerivedTable(
+					loukoum.Select("account_id", "amount").From("transactions").Where(loukoum.Condition("day").Equal("2024-01-01")),
+					"t",
+				)).
+				On(loukoum.Condition(loukoum.Column("accounts.id")).Equal(loukoum.Column("t.account_id"))).
+				WhenMatched(
+					loukoum.MergeDelete(),
+					loukoum.Condition(loukoum.Add(loukoum.Column("accounts.balance"), loukoum.Column("t.amount"))).Equal(0),
+				).
+				WhenMatched(
+					loukoum.MergeUpdate(loukoum.Pair("balance", loukoum.Add(loukoum.Column("accounts.balance"), loukoum.Column("t.amount")))),
+					loukoum.Condition("accounts.frozen").Equal(false),
+					loukoum.Condition("t.amount").NotEqual(0),
+				).
+				WhenMatched(loukoum.MergeDoNothing()).
+				WhenNotMatched(loukoum.MergeInsert(
+					loukoum.Pair("id", loukoum.Column("t.account_id")),
+					loukoum.Pair("balance", loukoum.Column("t.amount")),
+				)),
+			String: fmt.Sprint(
+				`MERGE INTO "accounts" USING (SELECT "account_id", "amount" FROM "transactions" WHERE ("day" = '2024-01-01')) AS "t" `,
+				`ON ("accounts"."id" = "t"."account_id") `,
+				`WHEN MATCHED AND (("accounts"."balance" + "t"."amount") = 0) THEN DELETE `,
+				`WHEN MATCHED AND (("accounts"."frozen" = false) AND ("t"."amount" != 0)) `,
+				`THEN UPDATE SET "balance" = ("accounts"."balance" + "t"."amount") `,
+				`WHEN MATCHED THEN DO NOTHING `,
+				`WHEN NOT MATCHED THEN INSERT ("balance", "id") VALUES ("t"."amount", "t"."account_id")`,
+			),
+			Query: fmt.Sprint(
+				`MERGE INTO "accounts" USING (SELECT "account_id", "amount" FROM "transactions" WHERE ("day" = $1)) AS "t" `,
+				`ON ("accounts"."id" = "t"."account_id") `,
+				`WHEN MATCHED AND (("accounts"."balance" + "t"."amount") = $2) THEN DELETE `,
+				`WHEN MATCHED AND (("accounts"."frozen" = $3) AND ("t"."amount" != $4)) `,
+				`THEN UPDATE SET "balance" = ("accounts"."balance" + "t"."amount") `,
+				`WHEN MATCHED THEN DO NOTHING `,
+				`WHEN NOT MATCHED THEN INSERT ("balance", "id") VALUES ("t"."amount", "t"."account_id")`,
+			),
+			NamedQuery: fmt.Sprint(
+				`MERGE INTO "accounts" USING (SELECT "account_id", "amount" FROM "transactions" WHERE ("day" = :arg_1)) AS "t" `,
+				`ON ("accounts"."id" = "t"."account_id") `,
+				`WHEN MATCHED AND (("accounts"."balance" + "t"."amount") = :arg_2) THEN DELETE `,
+				`WHEN MATCHED AND (("accounts"."frozen" = :arg_3) AND ("t"."amount" != :arg_4)) `,
+				`THEN UPDATE SET "balance" = ("accounts"."balance" + "t"."amount") `,
+				`WHEN MATCHED THEN DO NOTHING `,
+				`WHEN NOT MATCHED THEN INSERT ("balance", "id") VALUES ("t"."amount", "t"."account_id")`,
+			),
+			Args: []interface{}{"2024-01-01", 0, false, 0},
+		},
+		{
+			Name: "Values and returning",
+			Builder: loukoum.
+				Merge("settings").
+				With(loukoum.With("defaults", loukoum.Select("key").From("setting_defaults"))).
+				Using(loukoum.DerivedTable(
+					loukoum.Values([]interface{}{"theme", "dark"}, []interface{}{"lang", "fr"}),
+					"v", "key", "value",
+				)).
+				On(loukoum.Condition(loukoum.Column("settings.key")).Equal(loukoum.Column("v.key"))).
+				WhenMatched(loukoum.MergeUpdate(loukoum.Pair("value", loukoum.Column("v.value")))).
+				WhenNotMatched(loukoum.MergeInsert()).
+				Returning(loukoum.Raw("merge_action()"), "settings.key"),
+			String: fmt.Sprint(
+				`WITH defaults AS (SELECT "key" FROM "setting_defaults") MERGE INTO "settings" `,
+				`USING (VALUES ('theme', 'dark'), ('lang', 'fr')) AS "v"("key", "value") ON ("settings"."key" = "v"."key") `,
+				`WHEN MATCHED THEN UPDATE SET "value" = "v"."value" WHEN NOT MATCHED THEN INSERT DEFAULT VALUES `,
+				`RETURNING merge_action(), "settings"."key"`,
+			),
+			Query: fmt.Sprint(
+				`WITH defaults AS (SELECT "key" FROM "setting_defaults") MERGE INTO "settings" `,
+				`USING (VALUES ($1, $2), ($3, $4)) AS "v"("key", "value") ON ("settings"."key" = "v"."key") `,
+				`WHEN MATCHED THEN UPDATE SET "value" = "v"."value" WHEN NOT MATCHED THEN INSERT DEFAULT VALUES `,
+				`RETURNING merge_action(), "settings"."key"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH defaults AS (SELECT "key" FROM "setting_defaults") MERGE INTO "settings" `,
+				`USING (VALUES (:arg_1, :arg_2), (:arg_3, :arg_4)) AS "v"("key", "value") ON ("settings"."key" = "v"."key") `,
+				`WHEN MATCHED THEN UPDATE SET "value" = "v"."value" WHEN NOT MATCHED THEN INSERT DEFAULT VALUES `,
+				`RETURNING merge_action(), "settings"."key"`,
+			),
+			Args: []interface{}{"theme", "dark", "lang", "fr"},
+		},
+		{
+			Name: "Without when clause",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Merge("products").
+					Using("imports").
+					On(loukoum.Condition(loukoum.Column("products.sku")).Equal(loukoum.Column("imports.sku")))
+			},
+		},
+		{
+			Name: "Insert when matched",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Merge("products").
+					Using("imports").
+					On(loukoum.Condition(loukoum.Column("products.sku")).Equal(loukoum.Column("imports.sku"))).
+					WhenMatched(loukoum.MergeInsert())
+			},
+		},
+		{
+			Name: "Delete when not matched",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Merge("products").
+					Using("imports").
+					On(loukoum.Condition(loukoum.Column("products.sku")).Equal(loukoum.Column("imports.sku"))).
+					WhenNotMatched(loukoum.MergeDelete())
+			},
+		},
+	})
+}
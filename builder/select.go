@@ -93,9 +93,7 @@ func (b Select) join1(args []interface{}) Select {
 		panic("loukoum: given join clause is undefined")
 	}
 
-	b.query.Joins = append(b.query.Joins, join)
-
-	return b
+	return b.appendJoin(join)
 }
 
 func (b Select) join2(args []interface{}) Select {
@@ -104,9 +102,7 @@ func (b Select) join2(args []interface{}) Select {
 		panic("loukoum: given join clause is undefined")
 	}
 
-	b.query.Joins = append(b.query.Joins, join)
-
-	return b
+	return b.appendJoin(join)
 }
 
 func (b Select) join3(args []interface{}) Select {
@@ -116,26 +112,19 @@ func (b Select) join3(args []interface{}) Select {
 	case types.JoinType:
 		join.Type = value
 	default:
-		panic(fmt.Sprintf("loukoum: cannot use %T as join clause", args[1]))
+		panic(fmt.Sprintf("loukoum: cannot use %T as join clause", args[2]))
 	}
 
 	if join.IsEmpty() {
 		panic("loukoum: given join clause is undefined")
 	}
 
-	b.query.Joins = append(b.query.Joins, join)
-
-	return b
+	return b.appendJoin(join)
 }
 
 // With adds WITH clauses.
 func (b Select) With(args ...stmt.WithQuery) Select {
-	if b.query.With.IsEmpty() {
-		b.query.With = stmt.NewWith(args)
-		return b
-	}
-
-	b.query.With.Queries = append(b.query.With.Queries, args...)
+	b.query.With = MergeWith(b.query.With, args)
 	return b
 }
 
@@ -173,10 +162,12 @@ func (b Select) GroupBy(args ...interface{}) Select {
 		panic("loukoum: select builder has group by clause already defined")
 	}
 
-	columns := ToColumns(args)
-	group := stmt.NewGroupBy(columns)
+	group := stmt.NewGroupByExpressions(ToColumnExpressions(args))
+	if columns, ok := toColumnList(group.Expressions); ok {
+		group = stmt.NewGroupBy(columns)
+	}
 	if group.IsEmpty() {
-		panic("loukoum: given join clause is undefined")
+		panic("loukoum: given group by clause is undefined")
 	}
 
 	b.query.GroupBy = group
@@ -197,7 +188,9 @@ func (b Select) Having(condition stmt.Expression) Select {
 
 // OrderBy adds ORDER BY clauses.
 func (b Select) OrderBy(orders ...stmt.Order) Select {
-	b.query.OrderBy.Orders = append(b.query.OrderBy.Orders, orders...)
+	list := make([]stmt.Order, len(b.query.OrderBy.Orders), len(b.query.OrderBy.Orders)+len(orders))
+	copy(list, b.query.OrderBy.Orders)
+	b.query.OrderBy.Orders = append(list, orders...)
 	return b
 }
 
@@ -250,6 +243,12 @@ func (b Select) Comment(comment string) Select {
 	return b
 }
 
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Select) Clone() Select {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
 // String returns the underlying query as a raw statement.
 // This function should be used for debugging since it doesn't escape anything and is completely
 // vulnerable to SQL injection.
@@ -279,15 +278,26 @@ func (b Select) Statement() stmt.Statement {
 	return b.query
 }
 
+// appendJoin appends given join to a copy of the joins list, since it may be shared with another builder.
+func (b Select) appendJoin(join stmt.Join) Select {
+	joins := make([]stmt.Join, len(b.query.Joins), len(b.query.Joins)+1)
+	copy(joins, b.query.Joins)
+	b.query.Joins = append(joins, join)
+	return b
+}
+
 func handleSelectJoin(args []interface{}) stmt.Join {
 	join := stmt.Join{}
 	table := stmt.Table{}
+	derived := stmt.DerivedTable{}
 
 	switch value := args[0].(type) {
 	case string:
 		table = stmt.NewTable(value)
 	case stmt.Table:
 		table = value
+	case stmt.DerivedTable:
+		derived = value
 	default:
 		panic(fmt.Sprintf("loukoum: cannot use %T as table argument for join clause", args[0]))
 	}
@@ -295,15 +305,19 @@ func handleSelectJoin(args []interface{}) stmt.Join {
 	switch value := args[1].(type) {
 	case string:
 		join = parser.MustParseJoin(value)
-	case stmt.OnClause:
-		join = stmt.NewInnerJoin(table, value)
-	case stmt.InfixOnExpression:
+	case types.JoinType:
+		join = stmt.NewJoin(value, table, nil)
+	case stmt.JoinUsing:
+		join = stmt.NewInnerJoin(table, nil)
+		join.Using = value
+	case stmt.Expression:
 		join = stmt.NewInnerJoin(table, value)
 	default:
 		panic(fmt.Sprintf("loukoum: cannot use %T as condition for join clause", args[1]))
 	}
 
 	join.Table = table
+	join.Derived = derived
 
 	return join
 }
@@ -3,11 +3,15 @@ package builder_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	loukoum "github.com/ulule/loukoum/v3"
 	"github.com/ulule/loukoum/v3/builder"
 	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
 )
 
 func TestSelect_Value(t *testing.T) {
@@ -325,6 +329,77 @@ func TestSelect_From(t *testing.T) {
 			Builder:   loukoum.Select("a").From(loukoum.Raw("example1 ex1, example2 ex2")),
 			SameQuery: "SELECT \"a\" FROM example1 ex1, example2 ex2",
 		},
+		{
+			Name: "Subquery",
+			Builder: loukoum.
+				Select("t.user_id", "t.total").
+				From(loukoum.DerivedTable(
+					loukoum.Select("user_id", loukoum.Sum("amount").As("total")).
+						From("orders").
+						Where(loukoum.Condition("status").Equal("paid")).
+						GroupBy("user_id"),
+					"t",
+				)).
+				Where(loukoum.Condition("t.total").GreaterThan(1000)),
+			String: fmt.Sprint(
+				`SELECT "t"."user_id", "t"."total" FROM (SELECT "user_id", SUM(amount) AS total FROM "orders" `,
+				`WHERE ("status" = 'paid') GROUP BY "user_id") AS "t" WHERE ("t"."total" > 1000)`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "t"."user_id", "t"."total" FROM (SELECT "user_id", SUM(amount) AS total FROM "orders" `,
+				`WHERE ("status" = $1) GROUP BY "user_id") AS "t" WHERE ("t"."total" > $2)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "t"."user_id", "t"."total" FROM (SELECT "user_id", SUM(amount) AS total FROM "orders" `,
+				`WHERE ("status" = :arg_1) GROUP BY "user_id") AS "t" WHERE ("t"."total" > :arg_2)`,
+			),
+			Args: []interface{}{"paid", 1000},
+		},
+		{
+			Name: "Values",
+			Builder: loukoum.
+				Select("v.id", "v.name").
+				From(loukoum.DerivedTable(
+					loukoum.Values([]interface{}{1, "a"}, []interface{}{2, "b"}),
+					"v", "id", "name",
+				)),
+			String:     `SELECT "v"."id", "v"."name" FROM (VALUES (1, 'a'), (2, 'b')) AS "v"("id", "name")`,
+			Query:      `SELECT "v"."id", "v"."name" FROM (VALUES ($1, $2), ($3, $4)) AS "v"("id", "name")`,
+			NamedQuery: `SELECT "v"."id", "v"."name" FROM (VALUES (:arg_1, :arg_2), (:arg_3, :arg_4)) AS "v"("id", "name")`,
+			Args:       []interface{}{1, "a", 2, "b"},
+		},
+		{
+			Name: "Function with ordinality",
+			Builder: loukoum.
+				Select("u.id", "u.position").
+				From(loukoum.DerivedTable(
+					loukoum.Func("unnest", loukoum.Cast([]int64{4, 8}, "int[]").UseShorthand()),
+					"u",
+				).As("u", "id", "position").WithOrdinality()),
+			String:     `SELECT "u"."id", "u"."position" FROM unnest('{4,8}'::int[]) WITH ORDINALITY AS "u"("id", "position")`,
+			Query:      `SELECT "u"."id", "u"."position" FROM unnest($1::int[]) WITH ORDINALITY AS "u"("id", "position")`,
+			NamedQuery: `SELECT "u"."id", "u"."position" FROM unnest(CAST(:arg_1 AS int[])) WITH ORDINALITY AS "u"("id", "position")`, //nolint:lll
+			Args:       []interface{}{[]int64{4, 8}},
+		},
+		{
+			Name: "Table and lateral function",
+			Builder: loukoum.
+				Select("posts.id", "tag").
+				From("posts", loukoum.DerivedTable(loukoum.Func("unnest", loukoum.Column("posts.tags")), "tag").Lateral()),
+			SameQuery: `SELECT "posts"."id", "tag" FROM "posts", LATERAL unnest("posts"."tags") AS "tag"`,
+		},
+		{
+			Name: "Derived table without alias",
+			Failure: func() builder.Builder {
+				return loukoum.Select("a").From(loukoum.DerivedTable(loukoum.Select("a").From("b"), ""))
+			},
+		},
+		{
+			Name: "Ordinality on subquery",
+			Failure: func() builder.Builder {
+				return loukoum.Select("a").From(loukoum.DerivedTable(loukoum.Select("a").From("b"), "t").WithOrdinality())
+			},
+		},
 	})
 }
 
@@ -479,6 +554,178 @@ func TestSelect_Join(t *testing.T) {
 				"INNER JOIN \"test3\" ON ((\"test4\".\"uid\" = \"test3\".\"id\" OR \"test3\".\"e\" = \"test2\".\"e\") AND \"test3\".\"g\" = \"test2\".\"g\")",  //nolint:lll
 			),
 		},
+		{
+			Name: "Full",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("a", "b").
+					From("test1").
+					Join("test2", loukoum.On("test1.id", "test2.fk_id"), loukoum.FullJoin),
+				loukoum.
+					Select("a", "b").
+					From("test1").
+					Join("test2", "test1.id = test2.fk_id", loukoum.FullJoin),
+			},
+			SameQuery: `SELECT "a", "b" FROM "test1" FULL JOIN "test2" ON "test1"."id" = "test2"."fk_id"`,
+		},
+		{
+			Name: "Full Outer",
+			Builder: loukoum.
+				Select("a", "b").
+				From("test1").
+				Join("test2", loukoum.On("test1.id", "test2.fk_id"), loukoum.FullOuterJoin),
+			SameQuery: `SELECT "a", "b" FROM "test1" FULL OUTER JOIN "test2" ON "test1"."id" = "test2"."fk_id"`,
+		},
+		{
+			Name: "Cross",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("a", "b").
+					From("test1").
+					Join("test2", loukoum.CrossJoin),
+				loukoum.
+					Select("a", "b").
+					From("test1").
+					Join(stmt.NewCrossJoin(loukoum.Table("test2"))),
+			},
+			SameQuery: `SELECT "a", "b" FROM "test1" CROSS JOIN "test2"`,
+		},
+		{
+			Name: "Natural",
+			Builder: loukoum.
+				Select("a", "b").
+				From("test1").
+				Join("test2", loukoum.NaturalJoin),
+			SameQuery: `SELECT "a", "b" FROM "test1" NATURAL JOIN "test2"`,
+		},
+		{
+			Name: "Natural Left",
+			Builder: loukoum.
+				Select("a", "b").
+				From("test1").
+				Join(loukoum.Table("test2"), loukoum.NaturalLeftJoin),
+			SameQuery: `SELECT "a", "b" FROM "test1" NATURAL LEFT JOIN "test2"`,
+		},
+		{
+			Name: "Using",
+			Builder: loukoum.
+				Select("a", "b").
+				From("test1").
+				Join("test2", loukoum.Using("id", "locale")),
+			SameQuery: `SELECT "a", "b" FROM "test1" INNER JOIN "test2" USING ("id", "locale")`,
+		},
+		{
+			Name: "Left Using",
+			Builder: loukoum.
+				Select("a", "b").
+				From("test1").
+				Join("test2", loukoum.Using("id", "locale"), loukoum.LeftJoin),
+			SameQuery: `SELECT "a", "b" FROM "test1" LEFT JOIN "test2" USING ("id", "locale")`,
+		},
+		{
+			Name: "Expression",
+			Builder: loukoum.
+				Select("users.id").
+				From("users").
+				Join("orders", loukoum.And(
+					loukoum.Condition(loukoum.Column("orders.user_id")).Equal(loukoum.Column("users.id")),
+					loukoum.Condition("orders.status").In("paid", "shipped"),
+				), loukoum.LeftJoin).
+				Where(loukoum.Condition("users.deleted_at").IsNull(true)),
+			String: fmt.Sprint(
+				`SELECT "users"."id" FROM "users" LEFT JOIN "orders" ON `,
+				`(("orders"."user_id" = "users"."id") AND ("orders"."status" IN ('paid', 'shipped'))) `,
+				`WHERE ("users"."deleted_at" IS NULL)`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "users"."id" FROM "users" LEFT JOIN "orders" ON `,
+				`(("orders"."user_id" = "users"."id") AND ("orders"."status" IN ($1, $2))) `,
+				`WHERE ("users"."deleted_at" IS NULL)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "users"."id" FROM "users" LEFT JOIN "orders" ON `,
+				`(("orders"."user_id" = "users"."id") AND ("orders"."status" IN (:arg_1, :arg_2))) `,
+				`WHERE ("users"."deleted_at" IS NULL)`,
+			),
+			Args: []interface{}{"paid", "shipped"},
+		},
+		{
+			Name: "Lateral subquery",
+			Builder: loukoum.
+				Select("users.id", "last.created_at").
+				From("users").
+				Join(
+					loukoum.DerivedTable(
+						loukoum.Select("created_at").
+							From("orders").
+							Where(loukoum.Condition(loukoum.Column("orders.user_id")).Equal(loukoum.Column("users.id"))).
+							And(loukoum.Condition("orders.amount").GreaterThan(100)).
+							OrderBy(loukoum.Order("created_at", loukoum.Desc)).
+							Limit(1),
+						"last",
+					).Lateral(),
+					loukoum.Raw("true"),
+					loukoum.LeftJoin,
+				).
+				Where(loukoum.Condition("users.country").Equal("FR")),
+			String: fmt.Sprint(
+				`SELECT "users"."id", "last"."created_at" FROM "users" LEFT JOIN LATERAL `,
+				`(SELECT "created_at" FROM "orders" WHERE (("orders"."user_id" = "users"."id") `,
+				`AND ("orders"."amount" > 100)) ORDER BY created_at DESC LIMIT 1) AS "last" ON true `,
+				`WHERE ("users"."country" = 'FR')`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "users"."id", "last"."created_at" FROM "users" LEFT JOIN LATERAL `,
+				`(SELECT "created_at" FROM "orders" WHERE (("orders"."user_id" = "users"."id") `,
+				`AND ("orders"."amount" > $1)) ORDER BY created_at DESC LIMIT 1) AS "last" ON true `,
+				`WHERE ("users"."country" = $2)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "users"."id", "last"."created_at" FROM "users" LEFT JOIN LATERAL `,
+				`(SELECT "created_at" FROM "orders" WHERE (("orders"."user_id" = "users"."id") `,
+				`AND ("orders"."amount" > :arg_1)) ORDER BY created_at DESC LIMIT 1) AS "last" ON true `,
+				`WHERE ("users"."country" = :arg_2)`,
+			),
+			Args: []interface{}{100, "FR"},
+		},
+		{
+			Name: "Function",
+			Builder: loukoum.
+				Select("events.id", "tag").
+				From("events").
+				Join(
+					loukoum.DerivedTable(loukoum.Func("jsonb_array_elements_text", loukoum.Column("events.tags")), "tag"),
+					loukoum.CrossJoin,
+				),
+			SameQuery: `SELECT "events"."id", "tag" FROM "events" CROSS JOIN jsonb_array_elements_text("events"."tags") AS "tag"`, //nolint:lll
+		},
+		{
+			Name: "Derived table without alias",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Select("a").
+					From("test1").
+					Join(loukoum.DerivedTable(loukoum.Select("b").From("test2"), ""), loukoum.CrossJoin)
+			},
+		},
+		{
+			Name: "Cross join with condition",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Select("a").
+					From("test1").
+					Join("test2", loukoum.On("test1.id", "test2.fk_id"), loukoum.CrossJoin)
+			},
+		},
+		{
+			Name: "Join without condition",
+			Failure: func() builder.Builder {
+				return loukoum.
+					Select("a").
+					From("test1").
+					Join("test2", loukoum.LeftJoin)
+			},
+		},
 	})
 }
 
@@ -952,6 +1199,102 @@ func TestSelect_WhereLike(t *testing.T) {
 			NamedQuery: "SELECT \"id\" FROM \"table\" WHERE (\"title\" NOT ILIKE :arg_1)",
 			Args:       []interface{}{"foo%"},
 		},
+		{
+			Name: "Like escape",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Condition("title").LikeEscape("100!%%", "!")),
+			String:     `SELECT "id" FROM "table" WHERE ("title" LIKE '100!%%' ESCAPE E'!')`,
+			Query:      `SELECT "id" FROM "table" WHERE ("title" LIKE $1 ESCAPE E'!')`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("title" LIKE :arg_1 ESCAPE E'!')`,
+			Args:       []interface{}{"100!%%"},
+		},
+		{
+			Name: "Ilike without escape",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Condition("path").ILikeEscape(`C:\%`, "")),
+			String:     `SELECT "id" FROM "table" WHERE ("path" ILIKE 'C:\\%' ESCAPE E'')`,
+			Query:      `SELECT "id" FROM "table" WHERE ("path" ILIKE $1 ESCAPE E'')`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("path" ILIKE :arg_1 ESCAPE E'')`,
+			Args:       []interface{}{`C:\%`},
+		},
+		{
+			Name: "Starts with",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Condition("title").StartsWith("50%_off")),
+			String:     `SELECT "id" FROM "table" WHERE ("title" LIKE '50\\%\\_off%')`,
+			Query:      `SELECT "id" FROM "table" WHERE ("title" LIKE $1)`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("title" LIKE :arg_1)`,
+			Args:       []interface{}{`50\%\_off%`},
+		},
+		{
+			Name: "Ends with",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("table").Where(loukoum.Condition("path").EndsWith(`\tmp`)),
+				loukoum.Select("id").From("table").Where(loukoum.Condition("path").Like("%" + loukoum.EscapeLike(`\tmp`))),
+			},
+			Query:      `SELECT "id" FROM "table" WHERE ("path" LIKE $1)`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("path" LIKE :arg_1)`,
+			String:     `SELECT "id" FROM "table" WHERE ("path" LIKE '%\\\\tmp')`,
+			Args:       []interface{}{`%\\tmp`},
+		},
+		{
+			Name: "Contains text",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Func("lower", loukoum.Column("title")).ContainsText("a_b")),
+			String:     `SELECT "id" FROM "table" WHERE (lower("title") LIKE '%a\\_b%')`,
+			Query:      `SELECT "id" FROM "table" WHERE (lower("title") LIKE $1)`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE (lower("title") LIKE :arg_1)`,
+			Args:       []interface{}{`%a\_b%`},
+		},
+		{
+			Name: "Similar to",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Condition("code").SimilarTo("%(b|d)%")).
+				And(loukoum.Condition("code").NotSimilarTo("x%")),
+			String:     `SELECT "id" FROM "table" WHERE (("code" SIMILAR TO '%(b|d)%') AND ("code" NOT SIMILAR TO 'x%'))`,
+			Query:      `SELECT "id" FROM "table" WHERE (("code" SIMILAR TO $1) AND ("code" NOT SIMILAR TO $2))`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE (("code" SIMILAR TO :arg_1) AND ("code" NOT SIMILAR TO :arg_2))`,
+			Args:       []interface{}{"%(b|d)%", "x%"},
+		},
+		{
+			Name: "Regex",
+			Builder: loukoum.
+				Select("id").
+				From("table").
+				Where(loukoum.Condition("a").MatchRegex("^foo")).
+				And(loukoum.Condition("b").IMatchRegex("^bar")).
+				And(loukoum.Condition("c").NotMatchRegex("baz$")).
+				And(loukoum.Condition("d").NotIMatchRegex("qux$")),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "table" WHERE (((("a" ~ '^foo') AND ("b" ~* '^bar')) `,
+				`AND ("c" !~ 'baz$')) AND ("d" !~* 'qux$'))`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "id" FROM "table" WHERE (((("a" ~ $1) AND ("b" ~* $2)) `,
+				`AND ("c" !~ $3)) AND ("d" !~* $4))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "id" FROM "table" WHERE (((("a" ~ :arg_1) AND ("b" ~* :arg_2)) `,
+				`AND ("c" !~ :arg_3)) AND ("d" !~* :arg_4))`,
+			),
+			Args: []interface{}{"^foo", "^bar", "baz$", "qux$"},
+		},
+		{
+			Name: "Invalid escape",
+			Failure: func() builder.Builder {
+				return loukoum.Select("id").From("table").Where(loukoum.Condition("title").LikeEscape("a", "!!"))
+			},
+		},
 	})
 }
 
@@ -1155,7 +1498,7 @@ func TestSelect_WhereIn(t *testing.T) {
 				Select("id").From("table").Where(
 				loukoum.Condition("id").In([]int{}),
 			),
-			SameQuery: "SELECT \"id\" FROM \"table\" WHERE (\"id\" IN ())",
+			SameQuery: "SELECT \"id\" FROM \"table\" WHERE FALSE",
 		},
 		{
 			Name: "In nil slice",
@@ -1163,7 +1506,15 @@ func TestSelect_WhereIn(t *testing.T) {
 				Select("id").From("table").Where(
 				loukoum.Condition("id").In(nil),
 			),
-			SameQuery: "SELECT \"id\" FROM \"table\" WHERE (\"id\" IN ())",
+			SameQuery: "SELECT \"id\" FROM \"table\" WHERE FALSE",
+		},
+		{
+			Name: "Not in empty slice",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("table").Where(loukoum.Condition("id").NotIn([]string{})),
+				loukoum.Select("id").From("table").Where(loukoum.Condition("id").NotIn()),
+			},
+			SameQuery: "SELECT \"id\" FROM \"table\" WHERE TRUE",
 		},
 		{
 			Name: "Call In",
@@ -1185,104 +1536,1467 @@ func TestSelect_WhereIn(t *testing.T) {
 	})
 }
 
-func TestSelect_Exists(t *testing.T) {
+func TestSelect_WhereExpression(t *testing.T) {
 	RunBuilderTests(t, []BuilderTest{
 		{
-			Name: "Where clause",
-			Builder: loukoum.
-				Select("id").
-				From("users").
-				Where(loukoum.Condition("deleted_at").IsNull(true)).
-				And(loukoum.Exists(loukoum.Select(loukoum.Raw("1")).From("news").Where(
-					loukoum.Condition("news.created_at").GreaterThan(2)),
-				)),
+			Name: "Column to column",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("users").Where(loukoum.Condition("updated_at").GreaterThan(loukoum.Column("created_at"))),
+				loukoum.Select("id").From("users").
+					Where(loukoum.Condition(loukoum.Column("updated_at")).GreaterThan(loukoum.Column("created_at"))),
+			},
+			SameQuery: `SELECT "id" FROM "users" WHERE ("updated_at" > "created_at")`,
+		},
+		{
+			Name: "Aliased column",
+			Builders: []builder.Builder{
+				loukoum.Select(loukoum.Column("updated_at").As("updated")).From("users").
+					Where(loukoum.Condition(loukoum.Column("updated_at").As("updated")).
+						GreaterThan(loukoum.Column("created_at").As("created"))),
+				loukoum.Select(loukoum.Column("updated_at").As("updated")).From("users").
+					Where(loukoum.Condition(loukoum.Column("updated_at").As("updated")).GreaterThan(loukoum.Column("created_at"))),
+			},
+			SameQuery: `SELECT "updated_at" AS "updated" FROM "users" WHERE ("updated_at" > "created_at")`,
+		},
+		{
+			Name: "Column is distinct from column",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Condition(loukoum.Column("email")).IsDistinctFrom(loukoum.Column("backup_email"))),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("email" IS DISTINCT FROM "backup_email")`,
+		},
+		{
+			Name: "Column between columns",
+			Builder: loukoum.Select("id").From("events").
+				Where(loukoum.Func("now").Between(loukoum.Column("starts_at"), loukoum.Column("ends_at"))),
+			SameQuery: `SELECT "id" FROM "events" WHERE (now() BETWEEN "starts_at" AND "ends_at")`,
+		},
+		{
+			Name: "Value",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value("foo@example.com").Like(loukoum.Column("email_pattern"))),
+			String:     `SELECT "id" FROM "users" WHERE ('foo@example.com' LIKE "email_pattern")`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 LIKE "email_pattern")`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 LIKE "email_pattern")`,
+			Args:       []interface{}{"foo@example.com"},
+		},
+		{
+			Name: "Value between",
+			Builder: loukoum.Select("id").From("products").
+				Where(loukoum.Value(10).
+					NotBetween(loukoum.Column("min_quantity"), loukoum.Column("max_quantity"))),
+			String:     `SELECT "id" FROM "products" WHERE (10 NOT BETWEEN "min_quantity" AND "max_quantity")`,
+			Query:      `SELECT "id" FROM "products" WHERE ($1 NOT BETWEEN "min_quantity" AND "max_quantity")`,
+			NamedQuery: `SELECT "id" FROM "products" WHERE (:arg_1 NOT BETWEEN "min_quantity" AND "max_quantity")`,
+			Args:       []interface{}{10},
+		},
+		{
+			Name: "Arithmetic",
+			Builder: loukoum.Select("id").From("products").
+				Where(loukoum.Condition(loukoum.Mul(loukoum.Column("price"), loukoum.Column("quantity"))).
+					LessThanOrEqual(loukoum.Column("budget"))),
+			SameQuery: `SELECT "id" FROM "products" WHERE (("price" * "quantity") <= "budget")`,
+		},
+		{
+			Name: "Call",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("lower", loukoum.Column("email")).Equal(loukoum.Func("lower", loukoum.Column("login")))),
+			SameQuery: `SELECT "id" FROM "users" WHERE (lower("email") = lower("login"))`,
+		},
+		{
+			Name: "Subquery",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("users").Where(
+					loukoum.Subquery(loukoum.Select(loukoum.Count("id")).From("orders").
+						Where(loukoum.Condition("orders.user_id").Equal(loukoum.Column("users.id"))),
+					).GreaterThan(10),
+				),
+				loukoum.Select("id").From("users").Where(
+					loukoum.Condition(loukoum.Select(loukoum.Count("id")).From("orders").
+						Where(loukoum.Condition("orders.user_id").Equal(loukoum.Column("users.id"))),
+					).GreaterThan(10),
+				),
+			},
 			String: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > 2))))",
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) > 10)`,
 			),
 			Query: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > $1))))",
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) > $1)`,
 			),
 			NamedQuery: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > :arg_1))))",
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) > :arg_1)`,
 			),
-			Args: []interface{}{2},
+			Args: []interface{}{10},
 		},
-	})
-}
-
-func TestSelect_NotExists(t *testing.T) {
-	RunBuilderTests(t, []BuilderTest{
 		{
-			Name: "Where clause",
-			Builder: loukoum.
-				Select("id").
-				From("users").
-				Where(loukoum.Condition("deleted_at").IsNull(true)).
-				And(loukoum.NotExists(loukoum.Select(loukoum.Raw("1")).From("news").Where(
-					loukoum.Condition("news.created_at").GreaterThan(2)),
-				)),
-			String: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > 2))))",
+			Name: "Subquery on both sides",
+			Builder: loukoum.Select("id").From("teams").Where(
+				loukoum.Subquery(loukoum.Select(loukoum.Max("score")).From("games")).
+					IsNotDistinctFrom(loukoum.Select("score").From("records")),
 			),
-			Query: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > $1))))",
-			),
-			NamedQuery: fmt.Sprint(
-				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
-				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > :arg_1))))",
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "teams" WHERE ((SELECT MAX(score) FROM "games") `,
+				`IS NOT DISTINCT FROM (SELECT "score" FROM "records"))`,
 			),
-			Args: []interface{}{2},
+		},
+		{
+			Name: "Like subquery",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Condition("email").LikeEscape(loukoum.Select("pattern").From("rules"), "!")),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("email" LIKE (SELECT "pattern" FROM "rules") ESCAPE E'!')`,
 		},
 	})
 }
 
-func TestSelect_GroupBy(t *testing.T) {
+func TestSelect_WhereExpressionComparison(t *testing.T) {
+	count := loukoum.Select(loukoum.Count("id")).From("orders").
+		Where(loukoum.Condition("orders.user_id").Equal(loukoum.Column("users.id")))
+
 	RunBuilderTests(t, []BuilderTest{
 		{
-			Name: "One column",
-			Builders: []builder.Builder{
-				loukoum.
-					Select("name", loukoum.Raw("COUNT(*)")).
-					From("user").
-					Where(loukoum.Condition("disabled").IsNull(false)).
-					GroupBy("name"),
-				loukoum.
-					Select("name", loukoum.Raw("COUNT(*)")).
-					From("user").
-					Where(loukoum.Condition("disabled").IsNull(false)).
-					GroupBy(loukoum.Column("name")),
-			},
-			SameQuery: "SELECT \"name\", COUNT(*) FROM \"user\" WHERE (\"disabled\" IS NOT NULL) GROUP BY \"name\"",
+			Name: "Value between",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value(18).Between(loukoum.Column("min_age"), loukoum.Column("max_age"))),
+			String:     `SELECT "id" FROM "users" WHERE (18 BETWEEN "min_age" AND "max_age")`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 BETWEEN "min_age" AND "max_age")`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 BETWEEN "min_age" AND "max_age")`,
+			Args:       []interface{}{18},
 		},
 		{
-			Name: "Two columns",
-			Builders: []builder.Builder{
-				loukoum.
-					Select("name", "locale", loukoum.Raw("COUNT(*)")).
-					From("user").
-					Where(loukoum.Condition("disabled").IsNull(false)).
-					GroupBy("name", "locale"),
-				loukoum.
-					Select("name", "locale", loukoum.Raw("COUNT(*)")).
-					From("user").
-					Where(loukoum.Condition("disabled").IsNull(false)).
-					GroupBy(loukoum.Column("name"), loukoum.Column("locale")),
-			},
-			SameQuery: fmt.Sprint(
-				"SELECT \"name\", \"locale\", COUNT(*) FROM \"user\" ",
-				"WHERE (\"disabled\" IS NOT NULL) GROUP BY \"name\", \"locale\"",
-			),
+			Name: "Value is distinct from",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value("foo@example.com").IsDistinctFrom(loukoum.Column("email"))),
+			String:     `SELECT "id" FROM "users" WHERE ('foo@example.com' IS DISTINCT FROM "email")`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 IS DISTINCT FROM "email")`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 IS DISTINCT FROM "email")`,
+			Args:       []interface{}{"foo@example.com"},
 		},
 		{
-			Name: "Three columns",
-			Builders: []builder.Builder{
-				loukoum.
-					Select("name", "locale", "country", loukoum.Raw("COUNT(*)")).
+			Name: "Value ilike",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value("foo@example.com").ILike(loukoum.Column("email_pattern"))),
+			String:     `SELECT "id" FROM "users" WHERE ('foo@example.com' ILIKE "email_pattern")`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 ILIKE "email_pattern")`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 ILIKE "email_pattern")`,
+			Args:       []interface{}{"foo@example.com"},
+		},
+		{
+			Name: "Value not in",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value("admin").NotIn(loukoum.Column("role"), loukoum.Column("backup_role"))),
+			String:     `SELECT "id" FROM "users" WHERE ('admin' NOT IN ("role", "backup_role"))`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 NOT IN ("role", "backup_role"))`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 NOT IN ("role", "backup_role"))`,
+			Args:       []interface{}{"admin"},
+		},
+		{
+			Name: "Value is null",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Value(0).IsNull(false)),
+			String:     `SELECT "id" FROM "users" WHERE (0 IS NOT NULL)`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 IS NOT NULL)`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 IS NOT NULL)`,
+			Args:       []interface{}{0},
+		},
+		{
+			Name: "Call between",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("length", loukoum.Column("username")).
+					Between(loukoum.Column("min_length"), loukoum.Column("max_length"))),
+			SameQuery: `SELECT "id" FROM "users" WHERE (length("username") BETWEEN "min_length" AND "max_length")`,
+		},
+		{
+			Name: "Call is distinct from",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("lower", loukoum.Column("email")).IsDistinctFrom(loukoum.Column("login"))),
+			SameQuery: `SELECT "id" FROM "users" WHERE (lower("email") IS DISTINCT FROM "login")`,
+		},
+		{
+			Name: "Call like",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("lower", loukoum.Column("email")).Like(loukoum.Column("pattern"))),
+			SameQuery: `SELECT "id" FROM "users" WHERE (lower("email") LIKE "pattern")`,
+		},
+		{
+			Name: "Call not in",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("lower", loukoum.Column("email")).
+					NotIn(loukoum.Column("login"), loukoum.Column("backup_email"))),
+			SameQuery: `SELECT "id" FROM "users" WHERE (lower("email") NOT IN ("login", "backup_email"))`,
+		},
+		{
+			Name: "Call is null",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Func("nullif", loukoum.Column("nickname"), loukoum.Column("username")).IsNull(true)),
+			SameQuery: `SELECT "id" FROM "users" WHERE (nullif("nickname", "username") IS NULL)`,
+		},
+		{
+			Name: "Subquery between",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Subquery(count).Between(loukoum.Column("min_orders"), loukoum.Column("max_orders"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) BETWEEN "min_orders" AND "max_orders")`,
+			),
+		},
+		{
+			Name: "Subquery is distinct from",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Subquery(count).IsDistinctFrom(loukoum.Column("order_count"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) IS DISTINCT FROM "order_count")`,
+			),
+		},
+		{
+			Name: "Subquery ilike",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Subquery(loukoum.Select("name").From("teams").
+					Where(loukoum.Condition("teams.id").Equal(loukoum.Column("users.team_id")))).
+					ILike(loukoum.Column("team_pattern"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "users" WHERE ((SELECT "name" FROM "teams" `,
+				`WHERE ("teams"."id" = "users"."team_id")) ILIKE "team_pattern")`,
+			),
+		},
+		{
+			Name: "Subquery not in",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Subquery(count).NotIn(loukoum.Column("min_orders"), loukoum.Column("max_orders"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "users" WHERE ((SELECT COUNT(id) FROM "orders" `,
+				`WHERE ("orders"."user_id" = "users"."id")) NOT IN ("min_orders", "max_orders"))`,
+			),
+		},
+		{
+			Name: "Subquery is null",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Subquery(loukoum.Select("name").From("teams").
+					Where(loukoum.Condition("teams.id").Equal(loukoum.Column("users.team_id")))).IsNull(true)),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "users" WHERE ((SELECT "name" FROM "teams" `,
+				`WHERE ("teams"."id" = "users"."team_id")) IS NULL)`,
+			),
+		},
+		{
+			Name: "Row between",
+			Builder: loukoum.Select("id").From("events").
+				Where(loukoum.Row("year", "month").
+					Between(loukoum.Row("start_year", "start_month"), loukoum.Row("end_year", "end_month"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "events" WHERE (("year", "month") `,
+				`BETWEEN ("start_year", "start_month") AND ("end_year", "end_month"))`,
+			),
+		},
+		{
+			Name: "Row is distinct from",
+			Builder: loukoum.Select("id").From("events").
+				Where(loukoum.Row("year", "month").IsDistinctFrom(loukoum.Row("start_year", "start_month"))),
+			SameQuery: `SELECT "id" FROM "events" WHERE (("year", "month") IS DISTINCT FROM ("start_year", "start_month"))`,
+		},
+		{
+			Name: "Row not in",
+			Builder: loukoum.Select("id").From("events").
+				Where(loukoum.Row("year", "month").
+					NotIn(loukoum.Row("start_year", "start_month"), loukoum.Row("end_year", "end_month"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "events" WHERE (("year", "month") `,
+				`NOT IN (("start_year", "start_month"), ("end_year", "end_month")))`,
+			),
+		},
+		{
+			Name: "Row is null",
+			Builder: loukoum.Select("id").From("events").
+				Where(loukoum.Row("year", "month").IsNull(true)),
+			SameQuery: `SELECT "id" FROM "events" WHERE (("year", "month") IS NULL)`,
+		},
+	})
+}
+
+func TestSelect_WhereBoolean(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name:      "Column",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff")),
+			SameQuery: `SELECT "id" FROM "users" WHERE "is_staff"`,
+		},
+		{
+			Name: "Not column",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("users").Where(loukoum.Not("is_staff")),
+				loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").Not()),
+			},
+			SameQuery: `SELECT "id" FROM "users" WHERE (NOT "is_staff")`,
+		},
+		{
+			Name: "Column and condition",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Condition("is_active")).
+				And(loukoum.Condition("deleted_at").IsNull(true)),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_active" AND ("deleted_at" IS NULL))`,
+		},
+		{
+			Name:      "Is true",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsTrue()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS TRUE)`,
+		},
+		{
+			Name:      "Is not true",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsNotTrue()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS NOT TRUE)`,
+		},
+		{
+			Name:      "Is false",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsFalse()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS FALSE)`,
+		},
+		{
+			Name:      "Is not false",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsNotFalse()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS NOT FALSE)`,
+		},
+		{
+			Name:      "Is unknown",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsUnknown()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS UNKNOWN)`,
+		},
+		{
+			Name:      "Is not unknown",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.Condition("is_staff").IsNotUnknown()),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS NOT UNKNOWN)`,
+		},
+		{
+			Name: "And all",
+			Builder: loukoum.Select("id").From("users").Where(loukoum.AndAll(
+				loukoum.Condition("is_active"),
+				nil,
+				loukoum.Condition("name").Equal("foo"),
+				loukoum.AndAll(),
+				loukoum.Condition("age").GreaterThan(18),
+			)),
+			String:     `SELECT "id" FROM "users" WHERE (("is_active" AND ("name" = 'foo')) AND ("age" > 18))`,
+			Query:      `SELECT "id" FROM "users" WHERE (("is_active" AND ("name" = $1)) AND ("age" > $2))`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (("is_active" AND ("name" = :arg_1)) AND ("age" > :arg_2))`,
+			Args:       []interface{}{"foo", 18},
+		},
+		{
+			Name: "Or any",
+			Builder: loukoum.Select("id").From("users").Where(loukoum.OrAny(
+				loukoum.Condition("is_staff"),
+				loukoum.AndAll(loukoum.Condition("is_active"), loukoum.Condition("is_verified")),
+			)),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" OR ("is_active" AND "is_verified"))`,
+		},
+		{
+			Name: "Single condition",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.AndAll(nil, loukoum.Condition("is_staff").IsTrue())),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("is_staff" IS TRUE)`,
+		},
+		{
+			Name: "No condition",
+			Builder: loukoum.Select("id").From("users").
+				Where(loukoum.Condition("is_staff")).
+				And(loukoum.AndAll(nil)).
+				Or(loukoum.OrAny()),
+			SameQuery: `SELECT "id" FROM "users" WHERE "is_staff"`,
+		},
+		{
+			Name:      "Without condition",
+			Builder:   loukoum.Select("id").From("users").Where(loukoum.OrAny(nil)),
+			SameQuery: `SELECT "id" FROM "users"`,
+		},
+		{
+			Name: "Condition after no condition",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("users").
+					Where(loukoum.AndAll()).
+					And(loukoum.Condition("is_staff")),
+				loukoum.Select("id").From("users").
+					Where(loukoum.OrAny(nil)).
+					Or(loukoum.Condition("is_staff")),
+			},
+			SameQuery: `SELECT "id" FROM "users" WHERE "is_staff"`,
+		},
+	})
+}
+
+func TestSelect_WhereAny(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "In array",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("table").Where(loukoum.Condition("id").InArray([]int64{1, 2, 3})),
+				loukoum.Select("id").From("table").Where(loukoum.Condition("id").Equal(loukoum.Any([]int64{1, 2, 3}))),
+			},
+			String:     `SELECT "id" FROM "table" WHERE ("id" = ANY('{1,2,3}'))`,
+			Query:      `SELECT "id" FROM "table" WHERE ("id" = ANY($1))`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("id" = ANY(:arg_1))`,
+			Args:       []interface{}{pq.Array([]int64{1, 2, 3})},
+		},
+		{
+			Name:       "In empty array",
+			Builder:    loukoum.Select("id").From("table").Where(loukoum.Condition("id").InArray([]int64{})),
+			String:     `SELECT "id" FROM "table" WHERE ("id" = ANY('{}'))`,
+			Query:      `SELECT "id" FROM "table" WHERE ("id" = ANY($1))`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("id" = ANY(:arg_1))`,
+			Args:       []interface{}{pq.Array([]int64{})},
+		},
+		{
+			Name: "Not in array",
+			Builders: []builder.Builder{
+				loukoum.Select("id").From("table").Where(loukoum.Condition("status").NotInArray([]string{"a", "b"})),
+				loukoum.Select("id").From("table").Where(loukoum.Condition("status").NotEqual(loukoum.All([]string{"a", "b"}))),
+			},
+			String:     `SELECT "id" FROM "table" WHERE ("status" != ALL('{"a","b"}'))`,
+			Query:      `SELECT "id" FROM "table" WHERE ("status" != ALL($1))`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE ("status" != ALL(:arg_1))`,
+			Args:       []interface{}{pq.Array([]string{"a", "b"})},
+		},
+		{
+			Name: "Call in array",
+			Builder: loukoum.Select("id").From("table").Where(
+				loukoum.Func("lower", loukoum.Column("email")).InArray([]string{"foo@example.com"}),
+			),
+			String:     `SELECT "id" FROM "table" WHERE (lower("email") = ANY('{"foo@example.com"}'))`,
+			Query:      `SELECT "id" FROM "table" WHERE (lower("email") = ANY($1))`,
+			NamedQuery: `SELECT "id" FROM "table" WHERE (lower("email") = ANY(:arg_1))`,
+			Args:       []interface{}{pq.Array([]string{"foo@example.com"})},
+		},
+		{
+			Name: "Greater than all subquery",
+			Builder: loukoum.Select("id").From("products").Where(
+				loukoum.Condition("price").GreaterThan(loukoum.All(
+					loukoum.Select("price").From("products").Where(loukoum.Condition("category").Equal("books")),
+				)),
+			),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "products" WHERE ("price" > ALL(SELECT "price" FROM "products" `,
+				`WHERE ("category" = 'books')))`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "id" FROM "products" WHERE ("price" > ALL(SELECT "price" FROM "products" `,
+				`WHERE ("category" = $1)))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "id" FROM "products" WHERE ("price" > ALL(SELECT "price" FROM "products" `,
+				`WHERE ("category" = :arg_1)))`,
+			),
+			Args: []interface{}{"books"},
+		},
+		{
+			Name: "Equal any subquery",
+			Builder: loukoum.Select("id").From("users").Where(
+				loukoum.Condition("id").Equal(loukoum.Any(loukoum.Select("user_id").From("admins"))),
+			),
+			SameQuery: `SELECT "id" FROM "users" WHERE ("id" = ANY(SELECT "user_id" FROM "admins"))`,
+		},
+		{
+			Name: "Any column",
+			Builder: loukoum.Select("id").From("users").Where(
+				loukoum.Value("admin").Equal(loukoum.Any(loukoum.Column("roles"))),
+			),
+			String:     `SELECT "id" FROM "users" WHERE ('admin' = ANY("roles"))`,
+			Query:      `SELECT "id" FROM "users" WHERE ($1 = ANY("roles"))`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE (:arg_1 = ANY("roles"))`,
+			Args:       []interface{}{"admin"},
+		},
+	})
+}
+
+func TestSelect_WhereRow(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "In tuples",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("id").
+					From("memberships").
+					Where(loukoum.Row("org_id", "user_id").In([][]interface{}{{1, 2}, {3, 4}})),
+				loukoum.
+					Select("id").
+					From("memberships").
+					Where(loukoum.Row("org_id", "user_id").In([][]int{{1, 2}, {3, 4}})),
+				loukoum.
+					Select("id").
+					From("memberships").
+					Where(loukoum.Row("org_id", "user_id").In([]stmt.Row{
+						loukoum.Row(loukoum.Value(1), loukoum.Value(2)),
+						stmt.NewValueRow(3, 4),
+					})),
+			},
+			String:     `SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN ((1, 2), (3, 4)))`,
+			Query:      `SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN (($1, $2), ($3, $4)))`,
+			NamedQuery: `SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN ((:arg_1, :arg_2), (:arg_3, :arg_4)))`,
+			Args:       []interface{}{1, 2, 3, 4},
+		},
+		{
+			Name: "Not in tuples",
+			Builder: loukoum.
+				Select("id").
+				From("memberships").
+				Where(loukoum.Row("org_id", "role").NotIn([][]interface{}{{1, "admin"}})),
+			String:     `SELECT "id" FROM "memberships" WHERE (("org_id", "role") NOT IN ((1, 'admin')))`,
+			Query:      `SELECT "id" FROM "memberships" WHERE (("org_id", "role") NOT IN (($1, $2)))`,
+			NamedQuery: `SELECT "id" FROM "memberships" WHERE (("org_id", "role") NOT IN ((:arg_1, :arg_2)))`,
+			Args:       []interface{}{1, "admin"},
+		},
+		{
+			Name: "In empty tuples",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("id").
+					From("memberships").
+					Where(loukoum.Row("org_id", "user_id").In([][]interface{}{})),
+				loukoum.
+					Select("id").
+					From("memberships").
+					Where(loukoum.Row("org_id", "user_id").In([]stmt.Row{})),
+			},
+			SameQuery: `SELECT "id" FROM "memberships" WHERE FALSE`,
+		},
+		{
+			Name: "Not in empty tuples",
+			Builder: loukoum.
+				Select("id").
+				From("memberships").
+				Where(loukoum.Row("org_id", "user_id").NotIn([][]int{})),
+			SameQuery: `SELECT "id" FROM "memberships" WHERE TRUE`,
+		},
+		{
+			Name: "In subquery",
+			Builder: loukoum.
+				Select("id").
+				From("memberships").
+				Where(loukoum.Row("org_id", "user_id").In(
+					loukoum.Select("org_id", "user_id").From("invitations").Where(loukoum.Condition("accepted").Equal(true)),
+				)),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN `,
+				`(SELECT "org_id", "user_id" FROM "invitations" WHERE ("accepted" = true)))`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN `,
+				`(SELECT "org_id", "user_id" FROM "invitations" WHERE ("accepted" = $1)))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "id" FROM "memberships" WHERE (("org_id", "user_id") IN `,
+				`(SELECT "org_id", "user_id" FROM "invitations" WHERE ("accepted" = :arg_1)))`,
+			),
+			Args: []interface{}{true},
+		},
+		{
+			Name: "Greater than",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("id").
+					From("events").
+					Where(loukoum.Row("created_at", "id").GreaterThan([]interface{}{"2024-01-01", 10})),
+				loukoum.
+					Select("id").
+					From("events").
+					Where(loukoum.Row("created_at", "id").GreaterThan(
+						loukoum.Row(loukoum.Value("2024-01-01"), 10),
+					)),
+			},
+			String:     `SELECT "id" FROM "events" WHERE (("created_at", "id") > ('2024-01-01', 10))`,
+			Query:      `SELECT "id" FROM "events" WHERE (("created_at", "id") > ($1, $2))`,
+			NamedQuery: `SELECT "id" FROM "events" WHERE (("created_at", "id") > (:arg_1, :arg_2))`,
+			Args:       []interface{}{"2024-01-01", 10},
+		},
+		{
+			Name: "Comparisons",
+			Builder: loukoum.
+				Select("id").
+				From("events").
+				Where(loukoum.Row("a", "b").Equal(loukoum.Row("c", "d"))).
+				And(loukoum.Row("a", "b").NotEqual([]interface{}{1, 2})).
+				And(loukoum.Row("a", "b").LessThanOrEqual([]interface{}{3, 4})).
+				And(loukoum.Row("a", "b").IsDistinctFrom([]interface{}{5, nil})),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "events" WHERE ((((("a", "b") = ("c", "d")) AND (("a", "b") != (1, 2))) `,
+				`AND (("a", "b") <= (3, 4))) AND (("a", "b") IS DISTINCT FROM (5, NULL)))`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "id" FROM "events" WHERE ((((("a", "b") = ("c", "d")) AND (("a", "b") != ($1, $2))) `,
+				`AND (("a", "b") <= ($3, $4))) AND (("a", "b") IS DISTINCT FROM ($5, NULL)))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "id" FROM "events" WHERE ((((("a", "b") = ("c", "d")) AND (("a", "b") != (:arg_1, :arg_2))) `,
+				`AND (("a", "b") <= (:arg_3, :arg_4))) AND (("a", "b") IS DISTINCT FROM (:arg_5, NULL)))`,
+			),
+			Args: []interface{}{1, 2, 3, 4, 5},
+		},
+		{
+			Name: "Single value",
+			Builder: loukoum.
+				Select("id").
+				From("events").
+				Where(loukoum.Row("a").Equal([]interface{}{1})),
+			String:     `SELECT "id" FROM "events" WHERE (ROW("a") = ROW(1))`,
+			Query:      `SELECT "id" FROM "events" WHERE (ROW("a") = ROW($1))`,
+			NamedQuery: `SELECT "id" FROM "events" WHERE (ROW("a") = ROW(:arg_1))`,
+			Args:       []interface{}{1},
+		},
+	})
+}
+
+func TestSelect_Array(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Constructor and concatenation",
+			Builder: loukoum.
+				Select(loukoum.Concat(loukoum.Column("tags"), loukoum.Array("new", "hot")).As("tags")).
+				From("posts"),
+			String:     `SELECT ("tags" || ARRAY['new', 'hot']) AS "tags" FROM "posts"`,
+			Query:      `SELECT ("tags" || ARRAY[$1, $2]) AS "tags" FROM "posts"`,
+			NamedQuery: `SELECT ("tags" || ARRAY[:arg_1, :arg_2]) AS "tags" FROM "posts"`,
+			Args:       []interface{}{"new", "hot"},
+		},
+		{
+			Name: "Constructor from subquery",
+			Builder: loukoum.
+				Select("id", loukoum.Array(loukoum.Select("name").From("tags")).As("names")).
+				From("posts"),
+			SameQuery: `SELECT "id", ARRAY(SELECT "name" FROM "tags") AS "names" FROM "posts"`,
+		},
+		{
+			Name:      "Empty constructor with cast",
+			Builder:   loukoum.Select(loukoum.Cast(loukoum.Array(), "text[]").As("tags")),
+			SameQuery: `SELECT CAST(ARRAY[] AS text[]) AS "tags"`,
+		},
+		{
+			Name: "Empty constructor with shorthand cast",
+			Builder: loukoum.
+				Select(loukoum.Coalesce(loukoum.Column("tags"), loukoum.Cast(loukoum.Array(), "text[]").UseShorthand())).
+				From("posts"),
+			String:     `SELECT COALESCE("tags", (ARRAY[])::text[]) FROM "posts"`,
+			Query:      `SELECT COALESCE("tags", (ARRAY[])::text[]) FROM "posts"`,
+			NamedQuery: `SELECT COALESCE("tags", CAST(ARRAY[] AS text[])) FROM "posts"`,
+		},
+		{
+			Name: "Empty constructor",
+			Failure: func() builder.Builder {
+				return loukoum.Update("posts").Set(loukoum.Pair("tags", loukoum.Array()))
+			},
+		},
+		{
+			Name: "Subscripts",
+			Builder: loukoum.
+				Select("id").
+				From("posts").
+				Where(loukoum.Condition("tags").Index(1).Equal("go")).
+				And(loukoum.Condition("tags").Slice(2, 3).Contains([]string{"sql"})).
+				And(loukoum.Condition("tags").Slice(nil, 2).IsNull(false)),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "posts" WHERE ((("tags"[1] = 'go') AND ("tags"[2:3] @> '{"sql"}')) `,
+				`AND ("tags"[:2] IS NOT NULL))`,
+			),
+			Query: fmt.Sprint(
+				`SELECT "id" FROM "posts" WHERE ((("tags"[1] = $1) AND ("tags"[2:3] @> $2)) `,
+				`AND ("tags"[:2] IS NOT NULL))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`SELECT "id" FROM "posts" WHERE ((("tags"[1] = :arg_1) AND ("tags"[2:3] @> :arg_2)) `,
+				`AND ("tags"[:2] IS NOT NULL))`,
+			),
+			Args: []interface{}{"go", []string{"sql"}},
+		},
+		{
+			Name: "Subscript on call",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Func("string_to_array", loukoum.Column("path"), "/").Index(2).Equal("admin")),
+			String:     `SELECT "id" FROM "users" WHERE ((string_to_array("path", '/'))[2] = 'admin')`,
+			Query:      `SELECT "id" FROM "users" WHERE ((string_to_array("path", $1))[2] = $2)`,
+			NamedQuery: `SELECT "id" FROM "users" WHERE ((string_to_array("path", :arg_1))[2] = :arg_2)`,
+			Args:       []interface{}{"/", "admin"},
+		},
+		{
+			Name: "Length",
+			Builder: loukoum.
+				Select(loukoum.ArrayLength("tags", 1).As("length"), loukoum.Cardinality("tags").As("total")).
+				From("posts").
+				Where(loukoum.Cardinality("tags").GreaterThan(2)),
+			String:     `SELECT array_length("tags", 1) AS "length", cardinality("tags") AS "total" FROM "posts" WHERE (cardinality("tags") > 2)`,
+			Query:      `SELECT array_length("tags", 1) AS "length", cardinality("tags") AS "total" FROM "posts" WHERE (cardinality("tags") > $1)`,
+			NamedQuery: `SELECT array_length("tags", 1) AS "length", cardinality("tags") AS "total" FROM "posts" WHERE (cardinality("tags") > :arg_1)`,
+			Args:       []interface{}{2},
+		},
+	})
+}
+
+func TestSelect_Range(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Constructor",
+			Builder: loukoum.
+				Select("id").
+				From("bookings").
+				Where(loukoum.Condition("during").Overlap(loukoum.Range("tstzrange", from, to, "[)"))),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "bookings" WHERE ("during" && `,
+				`tstzrange('2024-01-01 00:00:00+00', '2024-02-01 00:00:00+00', '[)'))`,
+			),
+			Query:      `SELECT "id" FROM "bookings" WHERE ("during" && tstzrange($1, $2, '[)'))`,
+			NamedQuery: `SELECT "id" FROM "bookings" WHERE ("during" && tstzrange(:arg_1, :arg_2, '[)'))`,
+			Args:       []interface{}{from, to},
+		},
+		{
+			Name: "Range value",
+			Builder: loukoum.
+				Select("id").
+				From("products").
+				Where(loukoum.Condition("sizes").Contains(pgtype.Range[int32]{
+					Lower:     10,
+					Upper:     20,
+					LowerType: pgtype.Inclusive,
+					UpperType: pgtype.Exclusive,
+					Valid:     true,
+				})),
+			String:     `SELECT "id" FROM "products" WHERE ("sizes" @> '[10,20)')`,
+			Query:      `SELECT "id" FROM "products" WHERE ("sizes" @> $1)`,
+			NamedQuery: `SELECT "id" FROM "products" WHERE ("sizes" @> :arg_1)`,
+			Args: []interface{}{pgtype.Range[int32]{
+				Lower:     10,
+				Upper:     20,
+				LowerType: pgtype.Inclusive,
+				UpperType: pgtype.Exclusive,
+				Valid:     true,
+			}},
+		},
+		{
+			Name: "Unbounded and empty range values",
+			Builder: loukoum.
+				Select("id").
+				From("bookings").
+				Where(loukoum.Condition("during").Overlap(
+					pgtype.Range[time.Time]{Lower: from, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Valid: true},
+				)).
+				And(loukoum.Condition("during").NotEqual(
+					pgtype.Range[int64]{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Valid: true},
+				)),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "bookings" WHERE (("during" && '["2024-01-01 00:00:00+00:00",)') `,
+				`AND ("during" != 'empty'))`,
+			),
+			Query:      `SELECT "id" FROM "bookings" WHERE (("during" && $1) AND ("during" != $2))`,
+			NamedQuery: `SELECT "id" FROM "bookings" WHERE (("during" && :arg_1) AND ("during" != :arg_2))`,
+			Args: []interface{}{
+				pgtype.Range[time.Time]{Lower: from, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Valid: true},
+				pgtype.Range[int64]{LowerType: pgtype.Empty, UpperType: pgtype.Empty, Valid: true},
+			},
+		},
+		{
+			Name: "Operators",
+			Builder: loukoum.
+				Select("id").
+				From("bookings").
+				Where(loukoum.Condition("during").StrictlyLeftOf(loukoum.Column("other"))).
+				And(loukoum.Condition("during").StrictlyRightOf(loukoum.Column("other"))).
+				And(loukoum.Condition("during").DoesNotExtendRightOf(loukoum.Column("other"))).
+				And(loukoum.Condition("during").DoesNotExtendLeftOf(loukoum.Column("other"))).
+				And(loukoum.Condition("during").IsAdjacentTo(loukoum.Column("other"))),
+			SameQuery: fmt.Sprint(
+				`SELECT "id" FROM "bookings" WHERE ((((("during" << "other") AND ("during" >> "other")) `,
+				`AND ("during" &< "other")) AND ("during" &> "other")) AND ("during" -|- "other"))`,
+			),
+		},
+		{
+			Name: "Bounds",
+			Builder: loukoum.
+				Select(loukoum.Lower("during").As("start"), loukoum.Upper("during").As("end")).
+				From("bookings").
+				Where(loukoum.Upper("during").LessThan(to)),
+			String:     `SELECT lower("during") AS "start", upper("during") AS "end" FROM "bookings" WHERE (upper("during") < '2024-02-01 00:00:00+00')`,
+			Query:      `SELECT lower("during") AS "start", upper("during") AS "end" FROM "bookings" WHERE (upper("during") < $1)`,
+			NamedQuery: `SELECT lower("during") AS "start", upper("during") AS "end" FROM "bookings" WHERE (upper("during") < :arg_1)`,
+			Args:       []interface{}{to},
+		},
+		{
+			Name: "Invalid bounds",
+			Failure: func() builder.Builder {
+				return loukoum.Select("id").From("bookings").
+					Where(loukoum.Condition("during").Overlap(loukoum.Range("tstzrange", from, to, "[[")))
+			},
+		},
+	})
+}
+
+func TestSelect_Hstore(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Value",
+			Builder: loukoum.
+				Select("id").
+				From("products").
+				Where(loukoum.Condition("attributes").HstoreValue("color").Equal("red")),
+			String:     `SELECT "id" FROM "products" WHERE ("attributes" -> 'color' = 'red')`,
+			Query:      `SELECT "id" FROM "products" WHERE ("attributes" -> $1 = $2)`,
+			NamedQuery: `SELECT "id" FROM "products" WHERE ("attributes" -> :arg_1 = :arg_2)`,
+			Args:       []interface{}{"color", "red"},
+		},
+		{
+			Name: "Has key",
+			Builder: loukoum.
+				Select("id").
+				From("products").
+				Where(loukoum.Condition("attributes").HstoreHasKey("color")).
+				And(loukoum.Condition("attributes").HstoreHasAllKeys("size", "weight")),
+			String: fmt.Sprint(
+				`SELECT "id" FROM "products" WHERE (("attributes" ? 'color') `,
+				`AND ("attributes" ?& '{"size","weight"}'))`,
+			),
+			Query:      `SELECT "id" FROM "products" WHERE (("attributes" ? $1) AND ("attributes" ?& $2))`,
+			NamedQuery: `SELECT "id" FROM "products" WHERE (exist("attributes", :arg_1) AND exists_all("attributes", :arg_2))`,
+			Args:       []interface{}{"color", []string{"size", "weight"}},
+		},
+		{
+			Name: "Contains",
+			Builder: loukoum.
+				Select("id").
+				From("products").
+				Where(loukoum.Condition("attributes").Contains(map[string]string{"size": "L", "color": `"red"`})),
+			String:     `SELECT "id" FROM "products" WHERE ("attributes" @> '"color"=>"\\"red\\"", "size"=>"L"')`,
+			Query:      `SELECT "id" FROM "products" WHERE ("attributes" @> $1)`,
+			NamedQuery: `SELECT "id" FROM "products" WHERE ("attributes" @> :arg_1)`,
+			Args:       []interface{}{map[string]string{"size": "L", "color": `"red"`}},
+		},
+	})
+}
+
+func TestSelect_WhereJSON(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Extract text",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").ExtractText("plan").Equal("pro")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ->> 'plan' = 'pro')",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ->> $1 = $2)",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ->> :arg_1 = :arg_2)",
+			Args:       []interface{}{"plan", "pro"},
+		},
+		{
+			Name: "Extract nested",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").Extract("addresses").Extract(0).ExtractText("city").Equal("Paris")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" -> 'addresses' -> 0 ->> 'city' = 'Paris')",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" -> $1 -> 0 ->> $2 = $3)",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" -> :arg_1 -> 0 ->> :arg_2 = :arg_3)",
+			Args:       []interface{}{"addresses", "city", "Paris"},
+		},
+		{
+			Name: "Extract path",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").ExtractPathText("billing", "country").In("FR", "BE")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #>> '{\"billing\",\"country\"}' IN ('FR', 'BE'))",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #>> $1 IN ($2, $3))",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #>> :arg_1 IN (:arg_2, :arg_3))",
+			Args:       []interface{}{[]string{"billing", "country"}, "FR", "BE"},
+		},
+		{
+			Name: "Extract path and compare json",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").ExtractPath("flags").Contains(loukoum.JSON([]string{"beta"}))),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #> '{\"flags\"}' @> CAST('[\"beta\"]' AS jsonb))",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #> $1 @> CAST($2 AS jsonb))",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" #> :arg_1 @> CAST(:arg_2 AS jsonb))",
+			Args:       []interface{}{[]string{"flags"}, `["beta"]`},
+		},
+		{
+			Name: "Has key",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").HasKey("plan")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ? 'plan')",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ? $1)",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE jsonb_exists(\"metadata\", :arg_1)",
+			Args:       []interface{}{"plan"},
+		},
+		{
+			Name: "Has any keys",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").HasAnyKeys("plan", "trial")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ?| '{\"plan\",\"trial\"}')",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ?| $1)",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE jsonb_exists_any(\"metadata\", :arg_1)",
+			Args:       []interface{}{[]string{"plan", "trial"}},
+		},
+		{
+			Name: "Has all keys",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").HasAllKeys("plan", "trial")),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ?& '{\"plan\",\"trial\"}')",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (\"metadata\" ?& $1)",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE jsonb_exists_all(\"metadata\", :arg_1)",
+			Args:       []interface{}{[]string{"plan", "trial"}},
+		},
+		{
+			Name: "JSONPath",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("metadata").JSONPathExists("$.tags[*] ? (@ == \"vip\")")).
+				And(loukoum.Condition("metadata").JSONPathMatch("$.score > 10")),
+			String: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"metadata\" @? '$.tags[*] ? (@ == \"vip\")') ",
+				"AND (\"metadata\" @@ '$.score > 10'))",
+			),
+			Query: "SELECT \"id\" FROM \"users\" WHERE ((\"metadata\" @? $1) AND (\"metadata\" @@ $2))",
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE (jsonb_path_exists(\"metadata\", :arg_1) ",
+				"AND (\"metadata\" @@ :arg_2))",
+			),
+			Args: []interface{}{"$.tags[*] ? (@ == \"vip\")", "$.score > 10"},
+		},
+	})
+}
+
+func TestSelect_TextSearch(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Match",
+			Builder: loukoum.
+				Select("id").
+				From("news").
+				Where(loukoum.ToTSVector("french", "title").Match(loukoum.PlainToTSQuery("french", "chat noir"))),
+			String: fmt.Sprint(
+				"SELECT \"id\" FROM \"news\" WHERE ",
+				"(to_tsvector('french', \"title\") @@ plainto_tsquery('french', 'chat noir'))",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\" FROM \"news\" WHERE ",
+				"(to_tsvector('french', \"title\") @@ plainto_tsquery('french', $1))",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\" FROM \"news\" WHERE ",
+				"(to_tsvector('french', \"title\") @@ plainto_tsquery('french', :arg_1))",
+			),
+			Args: []interface{}{"chat noir"},
+		},
+		{
+			Name: "Match on identifier",
+			Builder: loukoum.
+				Select("id").
+				From("news").
+				Where(loukoum.Condition("search").Match(loukoum.WebSearchToTSQuery("", "chat -chien"))),
+			String:     "SELECT \"id\" FROM \"news\" WHERE (\"search\" @@ websearch_to_tsquery('chat -chien'))",
+			Query:      "SELECT \"id\" FROM \"news\" WHERE (\"search\" @@ websearch_to_tsquery($1))",
+			NamedQuery: "SELECT \"id\" FROM \"news\" WHERE (\"search\" @@ websearch_to_tsquery(:arg_1))",
+			Args:       []interface{}{"chat -chien"},
+		},
+		{
+			Name: "Rank and headline",
+			Builder: loukoum.
+				Select(
+					"id",
+					loukoum.TSRank("search", loukoum.ToTSQuery("english", "cat & dog")).As("rank"),
+					loukoum.TSHeadline("english", "body", loukoum.ToTSQuery("english", "cat & dog")).
+						WithOptions("MaxWords=10").
+						As("excerpt"),
+				).
+				From("news").
+				Where(loukoum.Condition("search").Match(loukoum.ToTSQuery("english", "cat & dog"))).
+				OrderBy(loukoum.TSRank("search", loukoum.ToTSQuery("english", "cat & dog")).Desc()),
+			String: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', 'cat & dog')) AS \"rank\", ",
+				"ts_headline('english', \"body\", to_tsquery('english', 'cat & dog'), 'MaxWords=10') AS \"excerpt\" ",
+				"FROM \"news\" WHERE (\"search\" @@ to_tsquery('english', 'cat & dog')) ",
+				"ORDER BY ts_rank(\"search\", to_tsquery('english', 'cat & dog')) DESC",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', $1)) AS \"rank\", ",
+				"ts_headline('english', \"body\", to_tsquery('english', $2), $3) AS \"excerpt\" ",
+				"FROM \"news\" WHERE (\"search\" @@ to_tsquery('english', $4)) ",
+				"ORDER BY ts_rank(\"search\", to_tsquery('english', $5)) DESC",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', :arg_1)) AS \"rank\", ",
+				"ts_headline('english', \"body\", to_tsquery('english', :arg_2), :arg_3) AS \"excerpt\" ",
+				"FROM \"news\" WHERE (\"search\" @@ to_tsquery('english', :arg_4)) ",
+				"ORDER BY ts_rank(\"search\", to_tsquery('english', :arg_5)) DESC",
+			),
+			Args: []interface{}{"cat & dog", "cat & dog", "MaxWords=10", "cat & dog", "cat & dog"},
+		},
+		{
+			Name: "Order by rank alias",
+			Builder: loukoum.
+				Select(loukoum.TSRank("search", loukoum.PhraseToTSQuery("simple", "black cat")).Normalize(32).As("rank")).
+				From("news").
+				OrderBy(loukoum.TSRank("search", loukoum.PhraseToTSQuery("simple", "black cat")).As("rank").Desc()),
+			String: fmt.Sprint(
+				"SELECT ts_rank(\"search\", phraseto_tsquery('simple', 'black cat'), 32) AS \"rank\" ",
+				"FROM \"news\" ORDER BY \"rank\" DESC",
+			),
+			Query: fmt.Sprint(
+				"SELECT ts_rank(\"search\", phraseto_tsquery('simple', $1), 32) AS \"rank\" ",
+				"FROM \"news\" ORDER BY \"rank\" DESC",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT ts_rank(\"search\", phraseto_tsquery('simple', :arg_1), 32) AS \"rank\" ",
+				"FROM \"news\" ORDER BY \"rank\" DESC",
+			),
+			Args: []interface{}{"black cat"},
+		},
+		{
+			Name: "Aliased rank in condition",
+			Builder: loukoum.
+				Select("id", loukoum.TSRank("search", loukoum.ToTSQuery("english", "cat")).As("rank")).
+				From("news").
+				Where(loukoum.Condition(loukoum.TSRank("search", loukoum.ToTSQuery("english", "cat"))).GreaterThan(0.5)),
+			String: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', 'cat')) AS \"rank\" ",
+				"FROM \"news\" WHERE (ts_rank(\"search\", to_tsquery('english', 'cat')) > 0.5)",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', $1)) AS \"rank\" ",
+				"FROM \"news\" WHERE (ts_rank(\"search\", to_tsquery('english', $2)) > $3)",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\", ts_rank(\"search\", to_tsquery('english', :arg_1)) AS \"rank\" ",
+				"FROM \"news\" WHERE (ts_rank(\"search\", to_tsquery('english', :arg_2)) > :arg_3)",
+			),
+			Args: []interface{}{"cat", "cat", 0.5},
+		},
+		{
+			Name: "Invalid configuration",
+			Failure: func() builder.Builder {
+				return loukoum.Select("id").
+					From("news").
+					Where(loukoum.ToTSVector("french'); --", "title").Match("chat"))
+			},
+		},
+	})
+}
+
+func TestSelect_Expressions(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Coalesce with alias",
+			Builder: loukoum.
+				Select("id", loukoum.Coalesce(loukoum.Column("nickname"), loukoum.Column("username")).As("name")).
+				From("users").
+				OrderBy(loukoum.Coalesce(loukoum.Column("nickname"), loukoum.Column("username")).As("name").Asc()),
+			SameQuery: fmt.Sprint(
+				"SELECT \"id\", COALESCE(\"nickname\", \"username\") AS \"name\" FROM \"users\" ",
+				"ORDER BY \"name\" ASC",
+			),
+		},
+		{
+			Name: "Column names",
+			Builder: loukoum.
+				Select(
+					loukoum.Coalesce("nickname", "username").As("name"),
+					loukoum.Cast("price", "numeric(10,2)"),
+					loukoum.Concat("first_name", loukoum.Value(" "), "last_name"),
+					loukoum.NullIf("nickname", loukoum.Value("")),
+					loukoum.Greatest("created_at", "updated_at"),
+					loukoum.Sub("price", "discount"),
+					loukoum.Negate("balance"),
+				).
+				From("users"),
+			String: fmt.Sprint(
+				"SELECT COALESCE(\"nickname\", \"username\") AS \"name\", CAST(\"price\" AS numeric(10,2)), ",
+				"((\"first_name\" || ' ') || \"last_name\"), NULLIF(\"nickname\", ''), ",
+				"GREATEST(\"created_at\", \"updated_at\"), (\"price\" - \"discount\"), (-\"balance\") FROM \"users\"",
+			),
+			Query: fmt.Sprint(
+				"SELECT COALESCE(\"nickname\", \"username\") AS \"name\", CAST(\"price\" AS numeric(10,2)), ",
+				"((\"first_name\" || $1) || \"last_name\"), NULLIF(\"nickname\", $2), ",
+				"GREATEST(\"created_at\", \"updated_at\"), (\"price\" - \"discount\"), (-\"balance\") FROM \"users\"",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT COALESCE(\"nickname\", \"username\") AS \"name\", CAST(\"price\" AS numeric(10,2)), ",
+				"((\"first_name\" || :arg_1) || \"last_name\"), NULLIF(\"nickname\", :arg_2), ",
+				"GREATEST(\"created_at\", \"updated_at\"), (\"price\" - \"discount\"), (-\"balance\") FROM \"users\"",
+			),
+			Args: []interface{}{" ", ""},
+		},
+		{
+			Name: "Arithmetic",
+			Builder: loukoum.
+				Select(loukoum.Mul(loukoum.Column("price"), 2).As("total")).
+				From("products").
+				Where(loukoum.Condition(loukoum.Sub(loukoum.Column("price"), loukoum.Column("discount"))).
+					GreaterThan(100)).
+				OrderBy(loukoum.Div(loukoum.Column("price"), loukoum.Column("weight")).Desc()),
+			String: fmt.Sprint(
+				"SELECT (\"price\" * 2) AS \"total\" FROM \"products\" ",
+				"WHERE ((\"price\" - \"discount\") > 100) ORDER BY (\"price\" / \"weight\") DESC",
+			),
+			Query: fmt.Sprint(
+				"SELECT (\"price\" * $1) AS \"total\" FROM \"products\" ",
+				"WHERE ((\"price\" - \"discount\") > $2) ORDER BY (\"price\" / \"weight\") DESC",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT (\"price\" * :arg_1) AS \"total\" FROM \"products\" ",
+				"WHERE ((\"price\" - \"discount\") > :arg_2) ORDER BY (\"price\" / \"weight\") DESC",
+			),
+			Args: []interface{}{2, 100},
+		},
+		{
+			Name: "Concat and negate",
+			Builder: loukoum.
+				Select(
+					loukoum.Concat(loukoum.Column("first_name"), loukoum.Value(" "), loukoum.Column("last_name")).
+						As("full_name"),
+					loukoum.Negate(loukoum.Column("balance")),
+				).
+				From("users"),
+			String:     "SELECT ((\"first_name\" || ' ') || \"last_name\") AS \"full_name\", (-\"balance\") FROM \"users\"",    //nolint:lll
+			Query:      "SELECT ((\"first_name\" || $1) || \"last_name\") AS \"full_name\", (-\"balance\") FROM \"users\"",     //nolint:lll
+			NamedQuery: "SELECT ((\"first_name\" || :arg_1) || \"last_name\") AS \"full_name\", (-\"balance\") FROM \"users\"", //nolint:lll
+			Args:       []interface{}{" "},
+		},
+		{
+			Name: "Negate negative literal",
+			Builder: loukoum.
+				Select(loukoum.Negate(-5).As("x"), loukoum.Negate(loukoum.Raw("-1")).As("y")).
+				From("users"),
+			String:     "SELECT (-(-5)) AS \"x\", (-(-1)) AS \"y\" FROM \"users\"",
+			Query:      "SELECT (-($1)) AS \"x\", (-(-1)) AS \"y\" FROM \"users\"",
+			NamedQuery: "SELECT (-(:arg_1)) AS \"x\", (-(-1)) AS \"y\" FROM \"users\"",
+			Args:       []interface{}{-5},
+		},
+		{
+			Name: "Not",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Not(loukoum.Condition("email").Like("%@example.com"))),
+			String:     "SELECT \"id\" FROM \"users\" WHERE (NOT (\"email\" LIKE '%@example.com'))",
+			Query:      "SELECT \"id\" FROM \"users\" WHERE (NOT (\"email\" LIKE $1))",
+			NamedQuery: "SELECT \"id\" FROM \"users\" WHERE (NOT (\"email\" LIKE :arg_1))",
+			Args:       []interface{}{"%@example.com"},
+		},
+		{
+			Name: "Case",
+			Builder: loukoum.
+				Select("id", loukoum.Case().
+					When(loukoum.Condition("amount").GreaterThan(1000), "large").
+					When(loukoum.Condition("amount").GreaterThan(100), "medium").
+					Else("small").
+					As("size"),
+				).
+				From("orders").
+				OrderBy(loukoum.Case().When(loukoum.Condition("status").Equal("pending"), 0).Else(1).Asc()),
+			String: fmt.Sprint(
+				"SELECT \"id\", CASE WHEN (\"amount\" > 1000) THEN 'large' WHEN (\"amount\" > 100) THEN 'medium' ",
+				"ELSE 'small' END AS \"size\" FROM \"orders\" ",
+				"ORDER BY CASE WHEN (\"status\" = 'pending') THEN 0 ELSE 1 END ASC",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\", CASE WHEN (\"amount\" > $1) THEN $2 WHEN (\"amount\" > $3) THEN $4 ",
+				"ELSE $5 END AS \"size\" FROM \"orders\" ",
+				"ORDER BY CASE WHEN (\"status\" = $6) THEN $7 ELSE $8 END ASC",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\", CASE WHEN (\"amount\" > :arg_1) THEN :arg_2 WHEN (\"amount\" > :arg_3) THEN :arg_4 ",
+				"ELSE :arg_5 END AS \"size\" FROM \"orders\" ",
+				"ORDER BY CASE WHEN (\"status\" = :arg_6) THEN :arg_7 ELSE :arg_8 END ASC",
+			),
+			Args: []interface{}{1000, "large", 100, "medium", "small", "pending", 0, 1},
+		},
+		{
+			Name: "Cast",
+			Builder: loukoum.
+				Select(loukoum.Cast(loukoum.Column("price"), "numeric(10,2)").As("price")).
+				From("products").
+				Where(loukoum.Condition(loukoum.Cast(loukoum.Column("created_at"), "date").UseShorthand()).
+					Equal("2024-01-01")),
+			String: fmt.Sprint(
+				"SELECT CAST(\"price\" AS numeric(10,2)) AS \"price\" FROM \"products\" ",
+				"WHERE (\"created_at\"::date = '2024-01-01')",
+			),
+			Query: fmt.Sprint(
+				"SELECT CAST(\"price\" AS numeric(10,2)) AS \"price\" FROM \"products\" ",
+				"WHERE (\"created_at\"::date = $1)",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT CAST(\"price\" AS numeric(10,2)) AS \"price\" FROM \"products\" ",
+				"WHERE (CAST(\"created_at\" AS date) = :arg_1)",
+			),
+			Args: []interface{}{"2024-01-01"},
+		},
+		{
+			Name: "NullIf, Greatest and Least",
+			Builder: loukoum.
+				Select(
+					loukoum.NullIf(loukoum.Column("nickname"), loukoum.Value("")),
+					loukoum.Greatest(loukoum.Column("a"), loukoum.Column("b")).As("max"),
+					loukoum.Least(loukoum.Column("a"), 10),
+				).
+				From("table"),
+			String:     "SELECT NULLIF(\"nickname\", ''), GREATEST(\"a\", \"b\") AS \"max\", LEAST(\"a\", 10) FROM \"table\"",         //nolint:lll
+			Query:      "SELECT NULLIF(\"nickname\", $1), GREATEST(\"a\", \"b\") AS \"max\", LEAST(\"a\", $2) FROM \"table\"",         //nolint:lll
+			NamedQuery: "SELECT NULLIF(\"nickname\", :arg_1), GREATEST(\"a\", \"b\") AS \"max\", LEAST(\"a\", :arg_2) FROM \"table\"", //nolint:lll
+			Args:       []interface{}{"", 10},
+		},
+		{
+			Name: "Cast to multi-word types",
+			Builder: loukoum.
+				Select(
+					loukoum.Cast(loukoum.Column("ratio"), "double precision"),
+					loukoum.Cast(loukoum.Column("created_at"), "timestamp(3) with time zone").UseShorthand(),
+					loukoum.Cast(loukoum.Column("tags"), "pg_catalog.varchar(255)[]"),
+				).
+				From("products"),
+			String: fmt.Sprint(
+				"SELECT CAST(\"ratio\" AS double precision), \"created_at\"::timestamp(3) with time zone, ",
+				"CAST(\"tags\" AS pg_catalog.varchar(255)[]) FROM \"products\"",
+			),
+			Query: fmt.Sprint(
+				"SELECT CAST(\"ratio\" AS double precision), \"created_at\"::timestamp(3) with time zone, ",
+				"CAST(\"tags\" AS pg_catalog.varchar(255)[]) FROM \"products\"",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT CAST(\"ratio\" AS double precision), CAST(\"created_at\" AS timestamp(3) with time zone), ",
+				"CAST(\"tags\" AS pg_catalog.varchar(255)[]) FROM \"products\"",
+			),
+		},
+		{
+			Name: "Invalid cast type",
+			Failure: func() builder.Builder {
+				return loukoum.Select(loukoum.Cast(loukoum.Column("price"), "int); DROP TABLE users; --"))
+			},
+		},
+		{
+			Name: "Invalid cast type with subquery",
+			Failure: func() builder.Builder {
+				return loukoum.Select(loukoum.Cast(loukoum.Column("price"), "text), (SELECT passwd FROM pg_shadow"))
+			},
+		},
+		{
+			Name: "Invalid shorthand cast type",
+			Failure: func() builder.Builder {
+				return loukoum.Select(loukoum.Cast(loukoum.Column("price"), "text UNION SELECT passwd FROM pg_shadow").
+					UseShorthand())
+			},
+		},
+		{
+			Name: "Invalid quoted cast type",
+			Failure: func() builder.Builder {
+				return loukoum.Select(loukoum.Cast(loukoum.Column("price"), `"text"`))
+			},
+		},
+	})
+}
+
+func TestSelect_Func(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Group by and order by",
+			Builder: loukoum.
+				Select(loukoum.Func("date_trunc", "day", loukoum.Column("created_at")).As("day"), loukoum.Count("*")).
+				From("events").
+				Where(loukoum.Func("date_part", "year", loukoum.Column("created_at")).GreaterThanOrEqual(2020)).
+				GroupBy(loukoum.Func("date_trunc", "day", loukoum.Column("created_at"))).
+				OrderBy(loukoum.Func("date_trunc", "day", loukoum.Column("created_at")).As("day").Desc()),
+			String: fmt.Sprint(
+				"SELECT date_trunc('day', \"created_at\") AS \"day\", COUNT(*) FROM \"events\" ",
+				"WHERE (date_part('year', \"created_at\") >= 2020) ",
+				"GROUP BY date_trunc('day', \"created_at\") ORDER BY \"day\" DESC",
+			),
+			Query: fmt.Sprint(
+				"SELECT date_trunc($1, \"created_at\") AS \"day\", COUNT(*) FROM \"events\" ",
+				"WHERE (date_part($2, \"created_at\") >= $3) ",
+				"GROUP BY date_trunc($4, \"created_at\") ORDER BY \"day\" DESC",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT date_trunc(:arg_1, \"created_at\") AS \"day\", COUNT(*) FROM \"events\" ",
+				"WHERE (date_part(:arg_2, \"created_at\") >= :arg_3) ",
+				"GROUP BY date_trunc(:arg_4, \"created_at\") ORDER BY \"day\" DESC",
+			),
+			Args: []interface{}{"day", "year", 2020, "day"},
+		},
+		{
+			Name: "Group by columns and call",
+			Builder: loukoum.
+				Select("country", loukoum.Func("lower", loukoum.Column("city")).As("city"), loukoum.Count("*")).
+				From("users").
+				GroupBy("country", loukoum.Func("lower", loukoum.Column("city"))),
+			SameQuery: fmt.Sprint(
+				"SELECT \"country\", lower(\"city\") AS \"city\", COUNT(*) FROM \"users\" ",
+				"GROUP BY \"country\", lower(\"city\")",
+			),
+		},
+		{
+			Name: "Comparisons",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Func("lower", loukoum.Column("email")).NotEqual("admin@example.com")).
+				And(loukoum.Func("length", loukoum.Column("username")).Between(3, 20)).
+				And(loukoum.Func("lower", loukoum.Column("username")).NotIn("root", "admin")).
+				And(loukoum.Func("nullif", loukoum.Column("nickname"), "").IsNull(false)),
+			String: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((((lower(\"email\") != 'admin@example.com') ",
+				"AND (length(\"username\") BETWEEN 3 AND 20)) AND (lower(\"username\") NOT IN ('root', 'admin'))) ",
+				"AND (nullif(\"nickname\", '') IS NOT NULL))",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((((lower(\"email\") != $1) ",
+				"AND (length(\"username\") BETWEEN $2 AND $3)) AND (lower(\"username\") NOT IN ($4, $5))) ",
+				"AND (nullif(\"nickname\", $6) IS NOT NULL))",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((((lower(\"email\") != :arg_1) ",
+				"AND (length(\"username\") BETWEEN :arg_2 AND :arg_3)) AND (lower(\"username\") NOT IN (:arg_4, :arg_5))) ",
+				"AND (nullif(\"nickname\", :arg_6) IS NOT NULL))",
+			),
+			Args: []interface{}{"admin@example.com", 3, 20, "root", "admin", ""},
+		},
+		{
+			Name: "Schema, named and variadic arguments",
+			Builder: loukoum.
+				Select(
+					loukoum.Func("billing.compute_price").
+						NamedArg("product_id", loukoum.Column("id")).
+						NamedArg("currency", "EUR").
+						As("price"),
+					loukoum.Func("concat_ws", ", ").VariadicArg([]string{"a", "b"}),
+				).
+				From("products"),
+			String: fmt.Sprint(
+				"SELECT billing.compute_price(product_id => \"id\", currency => 'EUR') AS \"price\", ",
+				"concat_ws(', ', VARIADIC '{\"a\",\"b\"}') FROM \"products\"",
+			),
+			Query: fmt.Sprint(
+				"SELECT billing.compute_price(product_id => \"id\", currency => $1) AS \"price\", ",
+				"concat_ws($2, VARIADIC $3) FROM \"products\"",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT billing.compute_price(product_id => \"id\", currency => :arg_1) AS \"price\", ",
+				"concat_ws(:arg_2, VARIADIC :arg_3) FROM \"products\"",
+			),
+			Args: []interface{}{"EUR", ", ", []string{"a", "b"}},
+		},
+		{
+			Name: "Invalid function name",
+			Failure: func() builder.Builder {
+				return loukoum.Select(loukoum.Func("now(); DROP TABLE users; --"))
+			},
+		},
+	})
+}
+
+func TestSelect_Exists(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Where clause",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("deleted_at").IsNull(true)).
+				And(loukoum.Exists(loukoum.Select(loukoum.Raw("1")).From("news").Where(
+					loukoum.Condition("news.created_at").GreaterThan(2)),
+				)),
+			String: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > 2))))",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > $1))))",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > :arg_1))))",
+			),
+			Args: []interface{}{2},
+		},
+	})
+}
+
+func TestSelect_NotExists(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Where clause",
+			Builder: loukoum.
+				Select("id").
+				From("users").
+				Where(loukoum.Condition("deleted_at").IsNull(true)).
+				And(loukoum.NotExists(loukoum.Select(loukoum.Raw("1")).From("news").Where(
+					loukoum.Condition("news.created_at").GreaterThan(2)),
+				)),
+			String: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > 2))))",
+			),
+			Query: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > $1))))",
+			),
+			NamedQuery: fmt.Sprint(
+				"SELECT \"id\" FROM \"users\" WHERE ((\"deleted_at\" IS NULL) AND ",
+				"(NOT EXISTS (SELECT 1 FROM \"news\" WHERE (\"news\".\"created_at\" > :arg_1))))",
+			),
+			Args: []interface{}{2},
+		},
+	})
+}
+
+func TestSelect_GroupBy(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "One column",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("name", loukoum.Raw("COUNT(*)")).
+					From("user").
+					Where(loukoum.Condition("disabled").IsNull(false)).
+					GroupBy("name"),
+				loukoum.
+					Select("name", loukoum.Raw("COUNT(*)")).
+					From("user").
+					Where(loukoum.Condition("disabled").IsNull(false)).
+					GroupBy(loukoum.Column("name")),
+			},
+			SameQuery: "SELECT \"name\", COUNT(*) FROM \"user\" WHERE (\"disabled\" IS NOT NULL) GROUP BY \"name\"",
+		},
+		{
+			Name: "Two columns",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("name", "locale", loukoum.Raw("COUNT(*)")).
+					From("user").
+					Where(loukoum.Condition("disabled").IsNull(false)).
+					GroupBy("name", "locale"),
+				loukoum.
+					Select("name", "locale", loukoum.Raw("COUNT(*)")).
+					From("user").
+					Where(loukoum.Condition("disabled").IsNull(false)).
+					GroupBy(loukoum.Column("name"), loukoum.Column("locale")),
+			},
+			SameQuery: fmt.Sprint(
+				"SELECT \"name\", \"locale\", COUNT(*) FROM \"user\" ",
+				"WHERE (\"disabled\" IS NOT NULL) GROUP BY \"name\", \"locale\"",
+			),
+		},
+		{
+			Name: "Three columns",
+			Builders: []builder.Builder{
+				loukoum.
+					Select("name", "locale", "country", loukoum.Raw("COUNT(*)")).
 					From("user").
 					Where(loukoum.Condition("disabled").IsNull(false)).
 					GroupBy("name", "locale", "country"),
@@ -1300,6 +3014,24 @@ func TestSelect_GroupBy(t *testing.T) {
 	})
 }
 
+func TestSelect_GroupByColumns(t *testing.T) {
+	is := require.New(t)
+
+	query := loukoum.Select("name", "email").From("users").GroupBy("name", "email").Statement().(stmt.Select)
+	is.Equal([]stmt.Column{stmt.NewColumn("name"), stmt.NewColumn("email")}, query.GroupBy.Columns)
+	is.Empty(query.GroupBy.Expressions)
+
+	query = loukoum.Select("name").From("users").GroupBy("name", loukoum.Lower("email")).Statement().(stmt.Select)
+	is.Empty(query.GroupBy.Columns)
+	is.Len(query.GroupBy.Expressions, 2)
+
+	group := stmt.NewGroupBy([]stmt.Column{stmt.NewColumn("name")})
+	group.Expressions = []stmt.Expression{loukoum.Lower("email")}
+	ctx := &types.RawContext{}
+	group.Write(ctx)
+	is.Equal(`GROUP BY "name", lower("email")`, ctx.Query())
+}
+
 func TestSelect_Having(t *testing.T) {
 	RunBuilderTests(t, []BuilderTest{
 		{
@@ -1608,6 +3340,127 @@ func TestSelect_With(t *testing.T) {
 			),
 			Args: []interface{}{0, 10},
 		},
+		{
+			Name: "Recursive",
+			Builder: loukoum.
+				Select("id", "parent_id").
+				From("tree").
+				With(loukoum.WithRecursive("tree",
+					loukoum.UnionAll(
+						loukoum.Select("id", "parent_id").
+							From("categories").
+							Where(loukoum.Condition("id").Equal(42)),
+						loukoum.Select("categories.id", "categories.parent_id").
+							From("categories").
+							Join("tree", loukoum.On("tree.id", "categories.parent_id")),
+					),
+					"id", "parent_id",
+				)),
+			String: fmt.Sprint(
+				`WITH RECURSIVE tree("id", "parent_id") AS (SELECT "id", "parent_id" FROM "categories" WHERE ("id" = 42) `,
+				`UNION ALL SELECT "categories"."id", "categories"."parent_id" FROM "categories" `,
+				`INNER JOIN "tree" ON "tree"."id" = "categories"."parent_id") SELECT "id", "parent_id" FROM "tree"`,
+			),
+			Query: fmt.Sprint(
+				`WITH RECURSIVE tree("id", "parent_id") AS (SELECT "id", "parent_id" FROM "categories" WHERE ("id" = $1) `,
+				`UNION ALL SELECT "categories"."id", "categories"."parent_id" FROM "categories" `,
+				`INNER JOIN "tree" ON "tree"."id" = "categories"."parent_id") SELECT "id", "parent_id" FROM "tree"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH RECURSIVE tree("id", "parent_id") AS (SELECT "id", "parent_id" FROM "categories" WHERE ("id" = :arg_1) `,
+				`UNION ALL SELECT "categories"."id", "categories"."parent_id" FROM "categories" `,
+				`INNER JOIN "tree" ON "tree"."id" = "categories"."parent_id") SELECT "id", "parent_id" FROM "tree"`,
+			),
+			Args: []interface{}{42},
+		},
+		{
+			Name: "Materialized",
+			Builder: loukoum.
+				Select("id").
+				From("active").
+				With(
+					loukoum.With("active", loukoum.Select("id").From("users").Where(loukoum.Condition("active").Equal(true))).
+						Materialized(),
+					loukoum.With("staff", loukoum.Select("id").From("users").Where(loukoum.Condition("staff").Equal(true))).
+						NotMaterialized(),
+				).
+				Where(loukoum.Condition("id").NotIn(loukoum.Select("id").From("staff"))),
+			String: fmt.Sprint(
+				`WITH active AS MATERIALIZED (SELECT "id" FROM "users" WHERE ("active" = true)), `,
+				`staff AS NOT MATERIALIZED (SELECT "id" FROM "users" WHERE ("staff" = true)) `,
+				`SELECT "id" FROM "active" WHERE ("id" NOT IN (SELECT "id" FROM "staff"))`,
+			),
+			Query: fmt.Sprint(
+				`WITH active AS MATERIALIZED (SELECT "id" FROM "users" WHERE ("active" = $1)), `,
+				`staff AS NOT MATERIALIZED (SELECT "id" FROM "users" WHERE ("staff" = $2)) `,
+				`SELECT "id" FROM "active" WHERE ("id" NOT IN (SELECT "id" FROM "staff"))`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH active AS MATERIALIZED (SELECT "id" FROM "users" WHERE ("active" = :arg_1)), `,
+				`staff AS NOT MATERIALIZED (SELECT "id" FROM "users" WHERE ("staff" = :arg_2)) `,
+				`SELECT "id" FROM "active" WHERE ("id" NOT IN (SELECT "id" FROM "staff"))`,
+			),
+			Args: []interface{}{true, true},
+		},
+		{
+			Name: "Data-modifying",
+			Builder: loukoum.
+				Select(loukoum.Count("*")).
+				From("deleted").
+				With(loukoum.With("deleted",
+					loukoum.Delete("sessions").
+						Where(loukoum.Condition("expires_at").LessThan(loukoum.Raw("NOW()"))).
+						Returning("id"),
+				)).
+				Where(loukoum.Condition("id").GreaterThan(100)),
+			String: fmt.Sprint(
+				`WITH deleted AS (DELETE FROM "sessions" WHERE ("expires_at" < NOW()) RETURNING "id") `,
+				`SELECT COUNT(*) FROM "deleted" WHERE ("id" > 100)`,
+			),
+			Query: fmt.Sprint(
+				`WITH deleted AS (DELETE FROM "sessions" WHERE ("expires_at" < NOW()) RETURNING "id") `,
+				`SELECT COUNT(*) FROM "deleted" WHERE ("id" > $1)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH deleted AS (DELETE FROM "sessions" WHERE ("expires_at" < NOW()) RETURNING "id") `,
+				`SELECT COUNT(*) FROM "deleted" WHERE ("id" > :arg_1)`,
+			),
+			Args: []interface{}{100},
+		},
+		{
+			Name: "Union with ordered and nested queries",
+			Builder: loukoum.
+				Select("id").
+				From("ids").
+				With(loukoum.With("ids", loukoum.Union(
+					loukoum.Select("id").From("posts").OrderBy(loukoum.Order("created_at", loukoum.Desc)).Limit(10),
+					loukoum.Select("id").From("pinned"),
+					loukoum.Intersect(loukoum.Select("id").From("featured"), loukoum.Select("id").From("published")),
+				))),
+			String: fmt.Sprint(
+				`WITH ids AS ((SELECT "id" FROM "posts" ORDER BY created_at DESC LIMIT 10) `,
+				`UNION SELECT "id" FROM "pinned" `,
+				`UNION (SELECT "id" FROM "featured" INTERSECT SELECT "id" FROM "published")) SELECT "id" FROM "ids"`,
+			),
+			Query: fmt.Sprint(
+				`WITH ids AS ((SELECT "id" FROM "posts" ORDER BY created_at DESC LIMIT 10) `,
+				`UNION SELECT "id" FROM "pinned" `,
+				`UNION (SELECT "id" FROM "featured" INTERSECT SELECT "id" FROM "published")) SELECT "id" FROM "ids"`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH ids AS ((SELECT "id" FROM "posts" ORDER BY created_at DESC LIMIT 10) `,
+				`UNION SELECT "id" FROM "pinned" `,
+				`UNION (SELECT "id" FROM "featured" INTERSECT SELECT "id" FROM "published")) SELECT "id" FROM "ids"`,
+			),
+		},
+		{
+			Name: "Union with a single query",
+			Failure: func() builder.Builder {
+				return loukoum.Select("id").From("tree").With(loukoum.WithRecursive("tree",
+					loukoum.UnionAll(loukoum.Select("id").From("categories")),
+				))
+			},
+		},
 	})
 }
 
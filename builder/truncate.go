@@ -0,0 +1,70 @@
+package builder
+
+import (
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Truncate is a builder used for "TRUNCATE" query.
+type Truncate struct {
+	query stmt.Truncate
+}
+
+// NewTruncate creates a new Truncate using given tables.
+func NewTruncate(tables ...interface{}) Truncate {
+	return Truncate{
+		query: stmt.NewTruncate(toUtilityTables(tables)),
+	}
+}
+
+// RestartIdentity resets sequences owned by columns of the truncated tables.
+func (b Truncate) RestartIdentity() Truncate {
+	b.query.RestartIdentity = true
+
+	return b
+}
+
+// Cascade also truncates tables that have foreign-key references to any of the truncated tables.
+func (b Truncate) Cascade() Truncate {
+	b.query.Cascade = true
+
+	return b
+}
+
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Truncate) Clone() Truncate {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
+// String returns the underlying query as a raw statement.
+// This function should be used for debugging since it doesn't escape anything and is completely
+// vulnerable to SQL injection.
+// You should use either NamedQuery() or Query()...
+func (b Truncate) String() string {
+	ctx := &types.RawContext{}
+	b.query.Write(ctx)
+	return ctx.Query()
+}
+
+// NamedQuery returns the underlying query as a named statement.
+func (b Truncate) NamedQuery() (string, map[string]interface{}) {
+	ctx := &types.NamedContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Query returns the underlying query as a regular statement.
+func (b Truncate) Query() (string, []interface{}) {
+	ctx := &types.StdContext{}
+	b.query.Write(ctx)
+	return ctx.Query(), ctx.Values()
+}
+
+// Statement returns underlying statement.
+func (b Truncate) Statement() stmt.Statement {
+	return b.query
+}
+
+// Ensure that Truncate is a Builder
+var _ Builder = Truncate{}
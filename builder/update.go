@@ -44,6 +44,7 @@ func (b Update) Using(args ...interface{}) Update {
 		panic("loukoum: using clause requires a column or an expression")
 	}
 
+	b.query.Set = stmt.Clone(b.query.Set)
 	for i := range args {
 		b.query.Set.Pairs.Use(stmt.NewExpression(args[i]))
 	}
@@ -53,12 +54,7 @@ func (b Update) Using(args ...interface{}) Update {
 
 // With adds WITH clauses.
 func (b Update) With(args ...stmt.WithQuery) Update {
-	if b.query.With.IsEmpty() {
-		b.query.With = stmt.NewWith(args)
-		return b
-	}
-
-	b.query.With.Queries = append(b.query.With.Queries, args...)
+	b.query.With = MergeWith(b.query.With, args)
 	return b
 }
 
@@ -113,6 +109,12 @@ func (b Update) Comment(comment string) Update {
 	return b
 }
 
+// Clone returns a deep copy of the builder, which doesn't share any slice or map with it.
+func (b Update) Clone() Update {
+	b.query = stmt.Clone(b.query)
+	return b
+}
+
 // String returns the underlying query as a raw statement.
 // This function should be used for debugging since it doesn't escape anything and is completely
 // vulnerable to SQL injection.
@@ -531,6 +531,103 @@ func TestUpdate_Set_Using(t *testing.T) {
 	})
 }
 
+func TestUpdate_Set_JSON(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "jsonb_set",
+			Builder: loukoum.
+				Update("users").
+				Set(loukoum.Pair("metadata", loukoum.JSONBSet("metadata", []string{"plan"}, "pro"))).
+				Where(loukoum.Condition("id").Equal(1)),
+			String: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_set(\"metadata\", '{\"plan\"}', CAST('\"pro\"' AS jsonb)) ",
+				"WHERE (\"id\" = 1)",
+			),
+			Query: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_set(\"metadata\", $1, CAST($2 AS jsonb)) ",
+				"WHERE (\"id\" = $3)",
+			),
+			NamedQuery: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_set(\"metadata\", :arg_1, CAST(:arg_2 AS jsonb)) ",
+				"WHERE (\"id\" = :arg_3)",
+			),
+			Args: []interface{}{[]string{"plan"}, `"pro"`, 1},
+		},
+		{
+			Name: "jsonb_build_object",
+			Builder: loukoum.
+				Update("users").
+				Set(loukoum.Pair("metadata", loukoum.JSONBBuildObject(
+					loukoum.Pair("plan", "pro"),
+					loukoum.Pair("seats", 10),
+				))),
+			String: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_build_object(CAST('plan' AS text), CAST('\"pro\"' AS jsonb), ",
+				"CAST('seats' AS text), CAST('10' AS jsonb))",
+			),
+			Query: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_build_object(CAST($1 AS text), CAST($2 AS jsonb), ",
+				"CAST($3 AS text), CAST($4 AS jsonb))",
+			),
+			NamedQuery: fmt.Sprint(
+				"UPDATE \"users\" SET \"metadata\" = jsonb_build_object(CAST(:arg_1 AS text), CAST(:arg_2 AS jsonb), ",
+				"CAST(:arg_3 AS text), CAST(:arg_4 AS jsonb))",
+			),
+			Args: []interface{}{"plan", `"pro"`, "seats", "10"},
+		},
+	})
+}
+
+func TestUpdate_Set_Expression(t *testing.T) {
+	RunBuilderTests(t, []BuilderTest{
+		{
+			Name: "Arithmetic and coalesce",
+			Builder: loukoum.
+				Update("accounts").
+				Set(
+					loukoum.Pair("balance", loukoum.Add(loukoum.Column("balance"), 100)),
+					loukoum.Pair("nickname", loukoum.Coalesce(loukoum.Column("nickname"), loukoum.Column("username"))),
+				),
+			String:     "UPDATE \"accounts\" SET \"balance\" = (\"balance\" + 100), \"nickname\" = COALESCE(\"nickname\", \"username\")",
+			Query:      "UPDATE \"accounts\" SET \"balance\" = (\"balance\" + $1), \"nickname\" = COALESCE(\"nickname\", \"username\")",
+			NamedQuery: "UPDATE \"accounts\" SET \"balance\" = (\"balance\" + :arg_1), \"nickname\" = COALESCE(\"nickname\", \"username\")", //nolint:lll
+			Args:       []interface{}{100},
+		},
+		{
+			Name: "Case",
+			Builder: loukoum.
+				Update("accounts").
+				Set(loukoum.Pair("status", loukoum.Case().
+					When(loukoum.Condition("balance").LessThan(0), "overdrawn").
+					Else(loukoum.Column("status")),
+				)),
+			String:     "UPDATE \"accounts\" SET \"status\" = CASE WHEN (\"balance\" < 0) THEN 'overdrawn' ELSE \"status\" END",
+			Query:      "UPDATE \"accounts\" SET \"status\" = CASE WHEN (\"balance\" < $1) THEN $2 ELSE \"status\" END",
+			NamedQuery: "UPDATE \"accounts\" SET \"status\" = CASE WHEN (\"balance\" < :arg_1) THEN :arg_2 ELSE \"status\" END",
+			Args:       []interface{}{0, "overdrawn"},
+		},
+		{
+			Name: "Func and returning",
+			Builder: loukoum.
+				Update("users").
+				Set(loukoum.Pair("email", loukoum.Func("lower", loukoum.Column("email")))).
+				Returning("id", loukoum.Func("md5", loukoum.Column("email")).As("hash")),
+			SameQuery: "UPDATE \"users\" SET \"email\" = lower(\"email\") RETURNING \"id\", md5(\"email\") AS \"hash\"",
+		},
+		{
+			Name: "Default",
+			Builder: loukoum.
+				Update("users").
+				Set(loukoum.Pair("status", loukoum.Default())).
+				Where(loukoum.Condition("id").Equal(1)),
+			String:     `UPDATE "users" SET "status" = DEFAULT WHERE ("id" = 1)`,
+			Query:      `UPDATE "users" SET "status" = DEFAULT WHERE ("id" = $1)`,
+			NamedQuery: `UPDATE "users" SET "status" = DEFAULT WHERE ("id" = :arg_1)`,
+			Args:       []interface{}{1},
+		},
+	})
+}
+
 func TestUpdate_OnlyTable(t *testing.T) {
 	RunBuilderTests(t, []BuilderTest{
 		{
@@ -598,6 +695,30 @@ func TestUpdate_From(t *testing.T) {
 			NamedQuery: "UPDATE \"table1\" SET \"a\" = :arg_1 FROM \"table2\" WHERE (\"table2\".\"id\" = table1.id)",
 			Args:       []interface{}{1},
 		},
+		{
+			Name: "Values",
+			Builder: loukoum.
+				Update("products").
+				Set(loukoum.Pair("price", loukoum.Column("v.price"))).
+				From(loukoum.DerivedTable(
+					loukoum.Values([]interface{}{1, 990}, []interface{}{2, 1490}),
+					"v", "id", "price",
+				)).
+				Where(loukoum.Condition(loukoum.Column("products.id")).Equal(loukoum.Column("v.id"))),
+			String: fmt.Sprint(
+				`UPDATE "products" SET "price" = "v"."price" FROM (VALUES (1, 990), (2, 1490)) AS "v"("id", "price") `,
+				`WHERE ("products"."id" = "v"."id")`,
+			),
+			Query: fmt.Sprint(
+				`UPDATE "products" SET "price" = "v"."price" FROM (VALUES ($1, $2), ($3, $4)) AS "v"("id", "price") `,
+				`WHERE ("products"."id" = "v"."id")`,
+			),
+			NamedQuery: fmt.Sprint(
+				`UPDATE "products" SET "price" = "v"."price" FROM (VALUES (:arg_1, :arg_2), (:arg_3, :arg_4)) AS "v"("id", "price") `,
+				`WHERE ("products"."id" = "v"."id")`,
+			),
+			Args: []interface{}{1, 990, 2, 1490},
+		},
 	})
 }
 
@@ -688,5 +809,31 @@ func TestUpdate_With(t *testing.T) {
 			),
 			Args: []interface{}{true},
 		},
+		{
+			Name: "Data-modifying",
+			Builder: loukoum.
+				Update("users").
+				With(loukoum.With("created",
+					loukoum.Insert("accounts").
+						Set(loukoum.Pair("owner_id", 42), loukoum.Pair("plan", "free")).
+						Returning("id", "owner_id"),
+				)).
+				Set(loukoum.Pair("account_id", loukoum.Raw("created.id"))).
+				From("created").
+				Where(loukoum.Condition("users.id").Equal(loukoum.Raw("created.owner_id"))),
+			String: fmt.Sprint(
+				`WITH created AS (INSERT INTO "accounts" ("owner_id", "plan") VALUES (42, 'free') RETURNING "id", "owner_id") `,
+				`UPDATE "users" SET "account_id" = created.id FROM "created" WHERE ("users"."id" = created.owner_id)`,
+			),
+			Query: fmt.Sprint(
+				`WITH created AS (INSERT INTO "accounts" ("owner_id", "plan") VALUES ($1, $2) RETURNING "id", "owner_id") `,
+				`UPDATE "users" SET "account_id" = created.id FROM "created" WHERE ("users"."id" = created.owner_id)`,
+			),
+			NamedQuery: fmt.Sprint(
+				`WITH created AS (INSERT INTO "accounts" ("owner_id", "plan") VALUES (:arg_1, :arg_2) RETURNING "id", "owner_id") `, //nolint:lll
+				`UPDATE "users" SET "account_id" = created.id FROM "created" WHERE ("users"."id" = created.owner_id)`,
+			),
+			Args: []interface{}{42, "free"},
+		},
 	})
 }
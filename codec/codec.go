@@ -0,0 +1,513 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ulule/loukoum/v3/stmt"
+)
+
+// Version is the version of the document schema.
+//
+// A document is made of the names listed in the schema of the nodes, which don't change when a Go type or
+// field is renamed. The version must be incremented when a node or a field is removed, when the type of a
+// field changes, or when the meaning of a value changes, such as a constant of the types package. Adding a
+// node or a field doesn't require a new version, since older documents are still decoded to the same statements.
+const Version = 1
+
+type document struct {
+	Version   int         `json:"version"`
+	Statement interface{} `json:"statement"`
+}
+
+var (
+	statementType = reflect.TypeOf((*stmt.Statement)(nil)).Elem()
+	stmtPackage   = statementType.PkgPath()
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// Marshal encodes given statement.
+func Marshal(statement stmt.Statement) ([]byte, error) {
+	if statement == nil || reflect.ValueOf(statement).Kind() == reflect.Ptr && reflect.ValueOf(statement).IsNil() {
+		return nil, errors.New("loukoum: cannot encode an undefined statement")
+	}
+
+	node, err := encodeTagged(reflect.ValueOf(statement), "statement")
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(document{
+		Version:   Version,
+		Statement: node,
+	})
+}
+
+// Unmarshal decodes a statement encoded by Marshal.
+func Unmarshal(data []byte) (stmt.Statement, error) {
+	doc := document{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(&doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "loukoum: cannot decode statement")
+	}
+	if doc.Version != Version {
+		return nil, errors.Errorf("loukoum: unsupported statement version %d", doc.Version)
+	}
+
+	value, err := decode(doc.Statement, statementType, "statement")
+	if err != nil {
+		return nil, err
+	}
+	if value.IsNil() {
+		return nil, errors.New("loukoum: statement is undefined")
+	}
+
+	// Cloning the statement rebuilds the unexported state of its nodes, which isn't encoded.
+	return stmt.Clone(value.Interface().(stmt.Statement)), nil
+}
+
+// ----------------------------------------------------------------------------
+// Encoder
+// ----------------------------------------------------------------------------
+
+// encode converts given value to a tree of JSON values, using its static type.
+func encode(value reflect.Value, path string) (interface{}, error) { // nolint: gocyclo
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return encodeTagged(value.Elem(), path)
+
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return encode(value.Elem(), path)
+
+	case reflect.Struct:
+		if value.Type() == timeType {
+			return encodeTime(value.Interface().(time.Time)), nil
+		}
+		if value.Type().PkgPath() != stmtPackage {
+			return encodeJSON(value, path)
+		}
+		return encodeNode(value, path)
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil, nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return encodeJSON(value, path)
+		}
+		list := make([]interface{}, value.Len())
+		for i := range list {
+			element, err := encode(value.Index(i), path)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = element
+		}
+		return list, nil
+
+	case reflect.Map:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return encodeMap(value, path)
+
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return value.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), nil
+
+	default:
+		return nil, errors.Errorf("loukoum: cannot encode %s in %s", value.Type(), path)
+	}
+}
+
+// encodeTagged encodes a value stored in an interface field, with its type name.
+func encodeTagged(value reflect.Value, path string) (interface{}, error) {
+	name, ok := typeName(value.Type())
+	if !ok {
+		return nil, errors.Errorf("loukoum: cannot encode unregistered type %s in %s", value.Type(), path)
+	}
+
+	node, err := encode(value, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"type":  name,
+		"value": node,
+	}, nil
+}
+
+// encodeNode encodes the exported fields of a statement with the names of its schema, omitting zero values.
+func encodeNode(value reflect.Value, path string) (interface{}, error) {
+	schema, ok := schemaOf(value.Type())
+	if !ok {
+		return nil, errors.Errorf("loukoum: cannot encode unregistered node %s in %s", value.Type(), path)
+	}
+	if schema.proxy != nil {
+		value = reflect.ValueOf(schema.proxy.encode(value.Interface()))
+	}
+
+	object := map[string]interface{}{}
+	for i := 0; i < schema.kind.NumField(); i++ {
+		field := schema.kind.Field(i)
+		if !field.IsExported() || value.Field(i).IsZero() {
+			continue
+		}
+
+		name := schema.fields[field.Name]
+		node, err := encode(value.Field(i), path+"."+name)
+		if err != nil {
+			return nil, err
+		}
+		object[name] = node
+	}
+
+	return object, nil
+}
+
+// encodeMap encodes a map as a list of entries, since a key may be a statement.
+// Entries are sorted for a deterministic output.
+func encodeMap(value reflect.Value, path string) (interface{}, error) {
+	type entry struct {
+		key  string
+		node interface{}
+	}
+
+	entries := make([]entry, 0, value.Len())
+	iterator := value.MapRange()
+	for iterator.Next() {
+		key, err := encode(iterator.Key(), path)
+		if err != nil {
+			return nil, err
+		}
+		element, err := encode(iterator.Value(), path)
+		if err != nil {
+			return nil, err
+		}
+
+		// A key is made of basic values, so it can always be marshaled.
+		data, _ := json.Marshal(key)
+		entries = append(entries, entry{
+			key: string(data),
+			node: map[string]interface{}{
+				"key":   key,
+				"value": element,
+			},
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	list := make([]interface{}, len(entries))
+	for i := range entries {
+		list[i] = entries[i].node
+	}
+	return list, nil
+}
+
+// encodeTime encodes a time with the name of its location, which isn't kept by its RFC 3339 representation.
+func encodeTime(value time.Time) interface{} {
+	object := map[string]interface{}{
+		"time":     value.Format(time.RFC3339Nano),
+		"location": value.Location().String(),
+	}
+
+	// A fixed zone, such as time.FixedZone("CET", 3600), doesn't exist in the time zone database.
+	start, end := value.ZoneBounds()
+	if start.IsZero() && end.IsZero() && value.Location() != time.UTC && value.Location() != time.Local {
+		object["fixed"] = true
+	}
+
+	return object
+}
+
+func encodeJSON(value reflect.Value, path string) (interface{}, error) {
+	data, err := json.Marshal(value.Interface())
+	if err != nil {
+		return nil, errors.Wrapf(err, "loukoum: cannot encode %s in %s", value.Type(), path)
+	}
+	return json.RawMessage(data), nil
+}
+
+// ----------------------------------------------------------------------------
+// Decoder
+// ----------------------------------------------------------------------------
+
+// decode converts given tree of JSON values to a value of given type.
+func decode(node interface{}, kind reflect.Type, path string) (reflect.Value, error) { // nolint: gocyclo
+	value := reflect.New(kind).Elem()
+	if node == nil {
+		return value, nil
+	}
+
+	switch kind.Kind() {
+	case reflect.Interface:
+		return decodeTagged(node, kind, path)
+
+	case reflect.Ptr:
+		element, err := decode(node, kind.Elem(), path)
+		if err != nil {
+			return value, err
+		}
+		value.Set(reflect.New(kind.Elem()))
+		value.Elem().Set(element)
+		return value, nil
+
+	case reflect.Struct:
+		if kind == timeType {
+			return decodeTime(node, path)
+		}
+		if kind.PkgPath() != stmtPackage {
+			return decodeJSON(node, kind, path)
+		}
+		return decodeNode(node, kind, path)
+
+	case reflect.Slice:
+		if kind.Elem().Kind() == reflect.Uint8 {
+			return decodeJSON(node, kind, path)
+		}
+		list, ok := node.([]interface{})
+		if !ok {
+			return value, mismatch(kind, path)
+		}
+		value.Set(reflect.MakeSlice(kind, len(list), len(list)))
+		for i := range list {
+			element, err := decode(list[i], kind.Elem(), path)
+			if err != nil {
+				return value, err
+			}
+			value.Index(i).Set(element)
+		}
+		return value, nil
+
+	case reflect.Map:
+		return decodeMap(node, kind, path)
+
+	case reflect.String:
+		text, ok := node.(string)
+		if !ok {
+			return value, mismatch(kind, path)
+		}
+		value.SetString(text)
+		return value, nil
+
+	case reflect.Bool:
+		boolean, ok := node.(bool)
+		if !ok {
+			return value, mismatch(kind, path)
+		}
+		value.SetBool(boolean)
+		return value, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return decodeNumber(node, kind, path)
+
+	default:
+		return value, errors.Errorf("loukoum: cannot decode %s in %s", kind, path)
+	}
+}
+
+// decodeTagged decodes a value stored in an interface field, using its type name.
+func decodeTagged(node interface{}, kind reflect.Type, path string) (reflect.Value, error) {
+	value := reflect.New(kind).Elem()
+
+	object, ok := node.(map[string]interface{})
+	if !ok {
+		return value, mismatch(kind, path)
+	}
+	name, ok := object["type"].(string)
+	if !ok {
+		return value, errors.Errorf("loukoum: missing type name in %s", path)
+	}
+
+	concrete, ok := lookup(name)
+	if !ok {
+		return value, errors.Errorf("loukoum: cannot decode unregistered type %s in %s", name, path)
+	}
+	if !concrete.AssignableTo(kind) {
+		return value, errors.Errorf("loukoum: cannot use %s as %s in %s", name, kind, path)
+	}
+
+	element, err := decode(object["value"], concrete, path)
+	if err != nil {
+		return value, err
+	}
+
+	value.Set(element)
+	return value, nil
+}
+
+// decodeNode decodes a statement using the field names of its schema.
+// Its unexported embedded fields, such as the comparison of a stmt.Value, are rebuilt by Unmarshal.
+func decodeNode(node interface{}, kind reflect.Type, path string) (reflect.Value, error) {
+	value := reflect.New(kind).Elem()
+
+	schema, ok := schemaOf(kind)
+	if !ok {
+		return value, errors.Errorf("loukoum: cannot decode unregistered node %s in %s", kind, path)
+	}
+	object, ok := node.(map[string]interface{})
+	if !ok {
+		return value, mismatch(kind, path)
+	}
+
+	fields := reflect.New(schema.kind).Elem()
+	for name := range object {
+		field, ok := schema.names[name]
+		if !ok {
+			return value, errors.Errorf("loukoum: unknown field %s of %s in %s", name, kind, path)
+		}
+
+		target := fields.FieldByName(field)
+		element, err := decode(object[name], target.Type(), path+"."+name)
+		if err != nil {
+			return value, err
+		}
+		target.Set(element)
+	}
+
+	if schema.proxy != nil {
+		value.Set(reflect.ValueOf(schema.proxy.decode(fields.Interface())))
+		return value, nil
+	}
+	return fields, nil
+}
+
+func decodeMap(node interface{}, kind reflect.Type, path string) (reflect.Value, error) {
+	value := reflect.New(kind).Elem()
+
+	list, ok := node.([]interface{})
+	if !ok {
+		return value, mismatch(kind, path)
+	}
+
+	value.Set(reflect.MakeMapWithSize(kind, len(list)))
+	for i := range list {
+		entry, ok := list[i].(map[string]interface{})
+		if !ok {
+			return value, mismatch(kind, path)
+		}
+
+		key, err := decode(entry["key"], kind.Key(), path)
+		if err != nil {
+			return value, err
+		}
+		element, err := decode(entry["value"], kind.Elem(), path)
+		if err != nil {
+			return value, err
+		}
+		value.SetMapIndex(key, element)
+	}
+
+	return value, nil
+}
+
+func decodeNumber(node interface{}, kind reflect.Type, path string) (reflect.Value, error) {
+	value := reflect.New(kind).Elem()
+
+	number, ok := node.(json.Number)
+	if !ok {
+		return value, mismatch(kind, path)
+	}
+
+	// Numbers are decoded with encoding/json, which reports overflows and invalid syntax.
+	err := json.Unmarshal([]byte(number.String()), value.Addr().Interface())
+	if err != nil {
+		return value, errors.Wrapf(err, "loukoum: cannot decode %s in %s", kind, path)
+	}
+
+	return value, nil
+}
+
+func decodeTime(node interface{}, path string) (reflect.Value, error) {
+	value := reflect.New(timeType).Elem()
+
+	object, ok := node.(map[string]interface{})
+	if !ok {
+		return value, mismatch(timeType, path)
+	}
+	text, ok := object["time"].(string)
+	if !ok {
+		return value, mismatch(timeType, path)
+	}
+	name, ok := object["location"].(string)
+	if !ok {
+		return value, mismatch(timeType, path)
+	}
+	fixed, _ := object["fixed"].(bool)
+
+	instant, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return value, errors.Wrapf(err, "loukoum: cannot decode %s in %s", timeType, path)
+	}
+
+	value.Set(reflect.ValueOf(instant.In(location(name, fixed, instant))))
+	return value, nil
+}
+
+// location returns the location of given name.
+// A location which is unknown on this system is replaced by a fixed zone using the offset of given time.
+func location(name string, fixed bool, instant time.Time) *time.Location {
+	_, offset := instant.Zone()
+
+	switch {
+	case name == "UTC" && !fixed:
+		return time.UTC
+	case name == "Local" && !fixed:
+		return time.Local
+	case fixed:
+		return time.FixedZone(name, offset)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone(name, offset)
+	}
+	return loc
+}
+
+func decodeJSON(node interface{}, kind reflect.Type, path string) (reflect.Value, error) {
+	value := reflect.New(kind)
+
+	// The node was decoded from a valid document, so it can always be marshaled again.
+	data, _ := json.Marshal(node)
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err := decoder.Decode(value.Interface())
+	if err != nil {
+		return value.Elem(), errors.Wrapf(err, "loukoum: cannot decode %s in %s", kind, path)
+	}
+
+	return value.Elem(), nil
+}
+
+func mismatch(kind reflect.Type, path string) error {
+	return errors.Errorf("loukoum: unexpected value for %s in %s", kind, path)
+}
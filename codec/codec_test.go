@@ -0,0 +1,306 @@
+package codec_test
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/builder"
+	"github.com/ulule/loukoum/v3/codec"
+	"github.com/ulule/loukoum/v3/stmt"
+)
+
+type Status string
+
+// packageStatus is used by tests which declare another Status type.
+type packageStatus = Status
+
+func (status Status) Value() (driver.Value, error) {
+	return strings.ToLower(string(status)), nil
+}
+
+func TestMarshal(t *testing.T) {
+	is := require.New(t)
+
+	codec.RegisterValue("codec_test.Status", Status(""))
+
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	builders := []builder.Builder{
+		loukoum.Select("u.id", loukoum.Count("p.id").As("posts")).
+			Distinct().
+			From(loukoum.Table("users").As("u")).
+			Join(loukoum.Table("posts").As("p"), loukoum.On("u.id", "p.user_id"), loukoum.LeftJoin).
+			Where(loukoum.Condition("u.created_at").GreaterThan(createdAt)).
+			And(loukoum.Or(
+				loukoum.Condition("u.status").In(Status("ACTIVE"), Status("PENDING")),
+				loukoum.Condition("u.tags").Overlap([]string{"admin", "staff"}),
+			)).
+			And(loukoum.Not(loukoum.Condition("u.avatar").Equal([]byte{0xde, 0xad}))).
+			And(loukoum.Condition("u.id").InArray([]int64{1, 2, 3})).
+			And(loukoum.Condition("u.id").NotIn(loukoum.Select("user_id").From("bans"))).
+			GroupBy("u.id").
+			Having(loukoum.Condition(loukoum.Count("p.id")).GreaterThan(uint8(2))).
+			OrderBy(loukoum.Order("posts", loukoum.Desc)).
+			Limit(10).
+			Offset(20),
+		loukoum.Select("id").
+			With(loukoum.With("recent", loukoum.Select("id").From("posts").
+				Where(loukoum.Condition("score").Between(1.5, float32(9.5))))).
+			From("recent").
+			Where(loukoum.Condition("title").ILike("%go%")).
+			And(loukoum.Condition("deleted_at").IsNull(true)),
+		loukoum.Insert("users").
+			Set(
+				loukoum.Pair("email", "tech@ulule.com"),
+				loukoum.Pair("enabled", true),
+				loukoum.Pair("created_at", loukoum.Raw("NOW()")),
+			).
+			OnConflict("email", loukoum.DoUpdate(loukoum.Pair("enabled", false))).
+			Returning("id"),
+		loukoum.Update("users").
+			Set(loukoum.Map{"enabled": false, "score": int16(3), "updated_at": loukoum.Raw("NOW()")}).
+			Where(loukoum.Condition("id").Equal(int32(42))),
+		loukoum.Explain(
+			loukoum.Select("id").
+				With(loukoum.With("ids", loukoum.Select("id").From(loukoum.Table("posts").Only())).Materialized()).
+				From("ids"),
+			loukoum.ExplainOptions{Analyze: true, Format: loukoum.ExplainJSON},
+		),
+		loukoum.CopyTo(loukoum.Select("id", "email").From("users").
+			Where(loukoum.Condition("email").Like("%@ulule.com"))).
+			Format(loukoum.CopyCSV).
+			Header(),
+		loukoum.Delete("users").
+			Using("bans").
+			Where(loukoum.Condition("users.id").Equal(loukoum.Column("bans.user_id"))).
+			And(loukoum.Condition("bans.author_id").NotEqual(loukoum.Named("author_id", 42))).
+			Returning("users.id"),
+	}
+
+	for _, expected := range builders {
+		data, err := codec.Marshal(expected.Statement())
+		is.NoError(err)
+
+		statement, err := codec.Unmarshal(data)
+		is.NoError(err)
+
+		again, err := codec.Marshal(statement)
+		is.NoError(err)
+		is.JSONEq(string(data), string(again))
+
+		actual := builder.NewCommand(statement)
+		is.Equal(expected.String(), actual.String())
+
+		query, args := expected.Query()
+		actualQuery, actualArgs := actual.Query()
+		is.Equal(query, actualQuery)
+		is.Equal(args, actualArgs)
+
+		query, namedArgs := expected.NamedQuery()
+		actualQuery, actualNamedArgs := actual.NamedQuery()
+		is.Equal(query, actualQuery)
+		is.Equal(namedArgs, actualNamedArgs)
+
+	}
+}
+
+func TestRegisterValue(t *testing.T) {
+	is := require.New(t)
+
+	// A type of another scope has the same string representation.
+	type Status int
+
+	codec.RegisterValue("codec_test.Status", packageStatus(""))
+	codec.RegisterValue("codec_test.Level", Status(0))
+
+	expected := loukoum.Select("id").From("users").
+		Where(loukoum.Condition("status").Equal(packageStatus("ACTIVE"))).
+		And(loukoum.Condition("level").Equal(stmt.NewValue(Status(2))))
+
+	data, err := codec.Marshal(expected.Statement())
+	is.NoError(err)
+	is.Contains(string(data), `"type":"codec_test.Status"`)
+	is.Contains(string(data), `"type":"codec_test.Level"`)
+
+	statement, err := codec.Unmarshal(data)
+	is.NoError(err)
+
+	query, args := expected.Query()
+	actualQuery, actualArgs := builder.NewCommand(statement).Query()
+	is.Equal(query, actualQuery)
+	is.Equal(args, actualArgs)
+
+	is.Panics(func() {
+		codec.RegisterValue("", Status(0))
+	})
+	is.Panics(func() {
+		codec.RegisterValue("codec_test.Status", Status(0))
+	})
+	is.Panics(func() {
+		codec.RegisterValue("codec_test.OtherStatus", packageStatus(""))
+	})
+}
+
+// Documents which are already persisted must still be decoded: a change breaking one of these documents
+// requires a new Version.
+func TestMarshal_Document(t *testing.T) {
+	is := require.New(t)
+
+	scenarios := []struct {
+		builder  builder.Builder
+		document string
+	}{
+		{
+			builder: loukoum.Select("id").From("users"),
+			document: `{
+				"version": 1,
+				"statement": {
+					"type": "select",
+					"value": {
+						"expressions": [{"type": "column", "value": {"name": "id"}}],
+						"from": {"tables": [{"type": "table", "value": {"name": "users"}}]}
+					}
+				}
+			}`,
+		},
+		{
+			builder: loukoum.Select(loukoum.Column("email").As("login")).
+				From(loukoum.Table("users").As("u")).
+				Where(loukoum.Condition("created_at").GreaterThan(time.Date(2024, 1, 1, 12, 0, 0, 0,
+					time.FixedZone("CET", 3600)))).
+				And(loukoum.Condition("org_id").In(loukoum.Named("org_id", 42), int64(7))),
+			document: `{
+				"version": 1,
+				"statement": {
+					"type": "select",
+					"value": {
+						"expressions": [{"type": "column", "value": {"name": "email", "alias": "login"}}],
+						"from": {"tables": [{"type": "table", "value": {"name": "users", "alias": "u"}}]},
+						"where": {"condition": {"type": "infix_expression", "value": {
+							"left": {"type": "infix_expression", "value": {
+								"left": {"type": "identifier", "value": {
+									"identifier": {"type": "string", "value": "created_at"}
+								}},
+								"operator": {"type": "comparison_operator", "value": {"operator": ">"}},
+								"right": {"type": "value", "value": {"value": {"type": "time.Time", "value": {
+									"time": "2024-01-01T12:00:00+01:00", "location": "CET", "fixed": true
+								}}}}
+							}},
+							"operator": {"type": "logical_operator", "value": {"operator": "AND"}},
+							"right": {"type": "in", "value": {
+								"expression": {"type": "identifier", "value": {
+									"identifier": {"type": "string", "value": "org_id"}
+								}},
+								"operator": {"operator": "IN"},
+								"value": {"type": "array", "value": {"values": [
+									{"type": "named_value", "value": {
+										"name": "org_id", "value": {"type": "int", "value": 42}
+									}},
+									{"type": "value", "value": {"value": {"type": "int64", "value": 7}}}
+								]}}
+							}}
+						}}}
+					}
+				}
+			}`,
+		},
+		{
+			builder: loukoum.Select(loukoum.Func("lower", loukoum.Column("email"))).From(loukoum.Table("users").Only()),
+			document: `{
+				"version": 1,
+				"statement": {
+					"type": "select",
+					"value": {
+						"expressions": [{"type": "call", "value": {
+							"function": "lower",
+							"args": [{"type": "column", "value": {"name": "email"}}]
+						}}],
+						"from": {"tables": [{"type": "table", "value": {"name": "users", "only": true}}]}
+					}
+				}
+			}`,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		data, err := codec.Marshal(scenario.builder.Statement())
+		is.NoError(err)
+		is.JSONEq(scenario.document, string(data))
+
+		statement, err := codec.Unmarshal([]byte(scenario.document))
+		is.NoError(err)
+
+		query, args := scenario.builder.Query()
+		actualQuery, actualArgs := builder.NewCommand(statement).Query()
+		is.Equal(query, actualQuery)
+		is.Equal(args, actualArgs)
+	}
+}
+
+func TestMarshal_Time(t *testing.T) {
+	is := require.New(t)
+
+	values := []time.Time{
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local),
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("UTC", 0)),
+		time.Date(2024, 1, 1, 12, 0, 0, 123456789, time.FixedZone("", -3*3600)),
+	}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err == nil {
+		values = append(values, time.Date(2024, 7, 1, 12, 0, 0, 0, paris))
+	}
+
+	for _, value := range values {
+		data, err := codec.Marshal(loukoum.Select("id").From("events").
+			Where(loukoum.Condition("created_at").Equal(value)).Statement())
+		is.NoError(err)
+
+		statement, err := codec.Unmarshal(data)
+		is.NoError(err)
+
+		_, args := builder.NewCommand(statement).Query()
+		is.Len(args, 1)
+		is.Equal(value, args[0])
+		is.Equal(value.Location().String(), args[0].(time.Time).Location().String())
+	}
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	is := require.New(t)
+
+	type Unknown struct{}
+
+	_, err := codec.Marshal(nil)
+	is.Error(err)
+
+	_, err = codec.Marshal(loukoum.Select("id").From("users").
+		Where(loukoum.Condition("id").Equal(stmt.NewValue(Unknown{}))).Statement())
+	is.Error(err)
+	is.Contains(err.Error(), "codec_test.Unknown")
+
+	scenarios := []string{
+		`{`,
+		`{"version": 2, "statement": {"type": "select", "value": {}}}`,
+		`{"version": 1}`,
+		`{"version": 1, "statement": {"type": "codec_test.Unknown", "value": {}}}`,
+		`{"version": 1, "statement": {"type": "string", "value": "id"}}`,
+		`{"version": 1, "statement": {"type": "stmt.Select", "value": {}}}`,
+		`{"version": 1, "statement": {"type": "select", "value": {"foo": 1}}}`,
+		`{"version": 1, "statement": {"type": "select", "value": {"Limit": {"count": 1}}}}`,
+		`{"version": 1, "statement": {"type": "select", "value": {"limit": {"count": "ten"}}}}`,
+		`{"version": 1, "statement": {"type": "select", "value": {"limit": {"count": 1.5}}}}`,
+	}
+
+	for _, scenario := range scenarios {
+		_, err := codec.Unmarshal([]byte(scenario))
+		is.Error(err, scenario)
+	}
+}
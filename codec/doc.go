@@ -0,0 +1,28 @@
+// Package codec serializes statements to JSON, so that a query can be stored or sent to another service,
+// then reloaded and rendered to the same SQL query and arguments.
+//
+// The document is versioned: every node of a statement is encoded using stable names for its type and its
+// fields, which don't depend on the Go names, and a node stored in an interface field, such as a condition
+// or a value, is tagged with its type name:
+//
+//	{"version": 1, "statement": {"type": "select", "value": {"expressions": [...], "from": {...}}}}
+//
+// Every statement and expression of the stmt package is supported, as well as values using a basic
+// type, a time, a slice or a map of them, and the array types of github.com/lib/pq.
+// A time is encoded with the name of its location, so it's decoded in the same location.
+// Other value types, such as a custom driver.Valuer, must be registered with a unique name using RegisterValue:
+//
+//	codec.RegisterValue("models.Status", models.Status(""))
+//
+// A document can contain raw SQL, such as a stmt.Raw, which is rendered as is: decoding a document is as
+// dangerous as executing a query it contains. Documents must never come from an untrusted input, such as
+// the parameters of an HTTP request, and they must be stored where only the application can modify them.
+//
+// A decoded statement can be rendered using a builder:
+//
+//	statement, err := codec.Unmarshal(data)
+//	if err != nil {
+//		return err
+//	}
+//	query, args := builder.NewCommand(statement).Query()
+package codec
@@ -0,0 +1,151 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var registry = newRegistry()
+
+// typeRegistry maps every registered type to the name used in a document, and the other way around.
+// It also holds the schema of every node, which doesn't change once the registry is created.
+type typeRegistry struct {
+	sync.RWMutex
+	types   map[string]reflect.Type
+	names   map[reflect.Type]string
+	schemas map[reflect.Type]schema
+}
+
+// A schema describes how a node is encoded: its fields are the ones of its proxy, if any.
+type schema struct {
+	kind   reflect.Type
+	fields map[string]string
+	names  map[string]string
+	proxy  *proxy
+}
+
+func newRegistry() *typeRegistry {
+	registry := &typeRegistry{
+		types:   map[string]reflect.Type{},
+		names:   map[reflect.Type]string{},
+		schemas: map[reflect.Type]schema{},
+	}
+
+	proxied := map[reflect.Type]*proxy{}
+	for i := range proxies {
+		proxied[reflect.TypeOf(proxies[i].node)] = &proxies[i]
+	}
+
+	for i := range nodes {
+		kind := reflect.TypeOf(nodes[i].value)
+		registry.add(nodes[i].name, kind)
+		registry.schemas[kind] = newSchema(kind, nodes[i].fields, proxied[kind])
+	}
+	for i := range values {
+		registry.add(values[i].name, reflect.TypeOf(values[i].value))
+	}
+
+	return registry
+}
+
+func (registry *typeRegistry) add(name string, kind reflect.Type) {
+	if _, ok := registry.types[name]; ok {
+		panic(fmt.Sprintf("loukoum: name %s is used by several types", name))
+	}
+	registry.types[name] = kind
+	registry.names[kind] = name
+}
+
+// newSchema returns the schema of given node, ensuring that every exported field has a name.
+// An unexported field is only allowed if it's embedded, such as the comparison of a stmt.Value, since it's
+// rebuilt by Unmarshal.
+func newSchema(kind reflect.Type, fields fields, proxy *proxy) schema {
+	schema := schema{
+		kind:   kind,
+		fields: fields,
+		names:  map[string]string{},
+		proxy:  proxy,
+	}
+	if proxy != nil {
+		schema.kind = reflect.TypeOf(proxy.value)
+	}
+
+	for i := 0; i < schema.kind.NumField(); i++ {
+		field := schema.kind.Field(i)
+		if !field.IsExported() {
+			if !field.Anonymous {
+				panic(fmt.Sprintf("loukoum: unexported field %s of %s requires a proxy", field.Name, kind))
+			}
+			continue
+		}
+		name, ok := fields[field.Name]
+		if !ok {
+			panic(fmt.Sprintf("loukoum: field %s of %s has no name", field.Name, kind))
+		}
+		if _, ok := schema.names[name]; ok {
+			panic(fmt.Sprintf("loukoum: name %s is used by several fields of %s", name, kind))
+		}
+		schema.names[name] = field.Name
+	}
+	if len(schema.names) != len(fields) {
+		panic(fmt.Sprintf("loukoum: unknown field names for %s", kind))
+	}
+
+	return schema
+}
+
+// RegisterValue registers the type of given value with given name, so that it can be encoded and decoded
+// when it's stored in an interface field, such as the value of a stmt.Value.
+// A type which isn't a statement of the stmt package is encoded using encoding/json.
+//
+// The name is stored in the documents instead of the Go type, so it must be unique, and it must not
+// change once documents are persisted, even if the type is renamed or moved to another package.
+func RegisterValue(name string, value interface{}) {
+	if name == "" {
+		panic("loukoum: cannot register a value without a name")
+	}
+	if value == nil {
+		panic("loukoum: cannot register a nil value")
+	}
+
+	kind := reflect.TypeOf(value)
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if other, ok := registry.types[name]; ok && other != kind {
+		panic(fmt.Sprintf("loukoum: name %s is already registered for %s", name, other))
+	}
+	if other, ok := registry.names[kind]; ok && other != name {
+		panic(fmt.Sprintf("loukoum: type %s is already registered as %s", kind, other))
+	}
+
+	registry.types[name] = kind
+	registry.names[kind] = name
+}
+
+// lookup returns the type registered with given name.
+func lookup(name string) (reflect.Type, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	kind, ok := registry.types[name]
+	return kind, ok
+}
+
+// typeName returns the name of given type, if it's registered.
+func typeName(kind reflect.Type) (string, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	name, ok := registry.names[kind]
+	return name, ok
+}
+
+// schemaOf returns the schema of given node.
+// Schemas are never modified once the registry is created, so they don't require a lock.
+func schemaOf(kind reflect.Type) (schema, bool) {
+	schema, ok := registry.schemas[kind]
+	return schema, ok
+}
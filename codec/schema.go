@@ -0,0 +1,268 @@
+package codec
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/ulule/loukoum/v3/stmt"
+)
+
+// fields maps the exported fields of a node to their names in a document.
+type fields map[string]string
+
+// A node is a type of the stmt package, with the names used in a document for its type and its fields.
+type node struct {
+	name   string
+	value  interface{}
+	fields fields
+}
+
+// nodes lists every type of the stmt package which can be encoded.
+//
+// Like the name of a registered value, the name of a node or of one of its fields is stored in the documents:
+// it must not change once documents are persisted, even if the Go type or field is renamed.
+// A new field must be given a name, otherwise the registry can't be created.
+var nodes = []node{
+	{"count", stmt.Count{}, fields{"Value": "value", "IsDistinct": "is_distinct", "Alias": "alias"}},
+	{"max", stmt.Max{}, fields{"Value": "value", "Alias": "alias"}},
+	{"min", stmt.Min{}, fields{"Value": "value", "Alias": "alias"}},
+	{"sum", stmt.Sum{}, fields{"Value": "value", "Alias": "alias"}},
+	{"alias", stmt.Alias{}, fields{"Value": "value", "Name": "name"}},
+	{"arithmetic", stmt.Arithmetic{}, fields{"Left": "left", "Operator": "operator", "Right": "right"}},
+	{"unary", stmt.Unary{}, fields{"Operator": "operator", "Value": "value"}},
+	{"array_constructor", stmt.ArrayConstructor{}, fields{"Values": "values"}},
+	{"subscript", stmt.Subscript{}, fields{"Value": "value", "Lower": "lower", "Upper": "upper", "Slice": "slice"}},
+	{"between", stmt.Between{}, fields{
+		"Identifier": "identifier", "Operator": "operator", "From": "from", "And": "and", "To": "to",
+	}},
+	{"case", stmt.Case{}, fields{"Operand": "operand", "Whens": "whens", "Default": "default"}},
+	{"when", stmt.When{}, fields{"Condition": "condition", "Result": "result"}},
+	{"cast", stmt.Cast{}, fields{"Value": "value", "Type": "type", "Shorthand": "shorthand"}},
+	{"column", stmt.Column{}, fields{"Name": "name", "Alias": "alias"}},
+	{"comment", stmt.Comment{}, fields{"Comment": "comment"}},
+	{"compound", stmt.Compound{}, fields{"Operator": "operator", "Queries": "queries"}},
+	{"on_conflict", stmt.OnConflict{}, fields{"Target": "target", "Action": "action"}},
+	{"conflict_target", stmt.ConflictTarget{}, fields{
+		"Columns": "columns", "Expressions": "expressions", "Condition": "condition", "Constraint": "constraint",
+	}},
+	{"conflict_update_action", stmt.ConflictUpdateAction{}, fields{
+		"Set": "set", "Condition": "condition", "Excluded": "excluded", "Except": "except",
+	}},
+	{"conflict_no_action", stmt.ConflictNoAction{}, fields{}},
+	{"copy", stmt.Copy{}, fields{
+		"Table": "table", "Columns": "columns", "Query": "query", "From": "from", "Options": "options",
+	}},
+	{"copy_options", stmt.CopyOptions{}, fields{
+		"Format": "format", "Header": "header", "Delimiter": "delimiter", "Null": "null", "HasNull": "has_null",
+		"Quote": "quote", "ForceQuote": "force_quote", "ForceQuoteAll": "force_quote_all",
+	}},
+	{"default", stmt.Default{}, fields{}},
+	{"delete", stmt.Delete{}, fields{
+		"With": "with", "From": "from", "Using": "using", "Where": "where", "Returning": "returning",
+		"Comment": "comment",
+	}},
+	{"derived_table", stmt.DerivedTable{}, fields{
+		"Source": "source", "Alias": "alias", "Columns": "columns", "IsLateral": "is_lateral",
+		"HasOrdinality": "has_ordinality",
+	}},
+	{"distinct_on", stmt.DistinctOn{}, fields{"Columns": "columns"}},
+	{"explain", stmt.Explain{}, fields{"Statement": "statement", "Options": "options"}},
+	{"explain_options", stmt.ExplainOptions{}, fields{
+		"Analyze": "analyze", "Buffers": "buffers", "Format": "format", "Verbose": "verbose", "Settings": "settings",
+	}},
+	{"identifier", stmt.Identifier{}, fields{"Identifier": "identifier"}},
+	{"value", stmt.Value{}, fields{"Value": "value"}},
+	{"array_list", stmt.ArrayList{}, fields{"Values": "values"}},
+	{"array", stmt.Array{}, fields{"Values": "values"}},
+	{"raw", stmt.Raw{}, fields{"Value": "value"}},
+	{"wrapper", stmt.Wrapper{}, fields{"Value": "value"}},
+	{"call", stmt.Call{}, fields{"Function": "function", "Args": "args"}},
+	{"argument", stmt.Argument{}, fields{"Name": "name", "Variadic": "variadic", "Value": "value"}},
+	{"from", stmt.From{}, fields{"Tables": "tables"}},
+	{"group_by", stmt.GroupBy{}, fields{"Columns": "columns", "Expressions": "expressions"}},
+	{"having", stmt.Having{}, fields{"Statement": "statement", "Condition": "condition"}},
+	{"in", stmt.In{}, fields{"Expression": "expression", "Operator": "operator", "Value": "value"}},
+	{"infix_expression", stmt.InfixExpression{}, fields{"Left": "left", "Operator": "operator", "Right": "right"}},
+	{"insert", stmt.Insert{}, fields{
+		"With": "with", "Into": "into", "Columns": "columns", "Overriding": "overriding", "Values": "values",
+		"Select": "select", "Default": "default", "OnConflict": "on_conflict", "Returning": "returning",
+		"Comment": "comment",
+	}},
+	{"into", stmt.Into{}, fields{"Table": "table"}},
+	{"join", stmt.Join{}, fields{
+		"Type": "type", "Table": "table", "Derived": "derived", "Condition": "condition", "Using": "using",
+	}},
+	{"join_using", stmt.JoinUsing{}, fields{"Columns": "columns"}},
+	{"json_extract", stmt.JSONExtract{}, fields{"Document": "document", "Operator": "operator", "Key": "key"}},
+	{"json_existence", stmt.JSONExistence{}, fields{
+		"Document": "document", "Operator": "operator", "Value": "value", "Hstore": "hstore",
+	}},
+	{"json_value", stmt.JSONValue{}, fields{"Value": "value"}},
+	{"like_escape", stmt.LikeEscape{}, fields{"Pattern": "pattern", "Escape": "escape"}},
+	{"limit", stmt.Limit{}, fields{"Count": "count"}},
+	{"lock", stmt.Lock{}, fields{"Tables": "tables", "Mode": "mode", "NoWait": "no_wait"}},
+	{"merge", stmt.Merge{}, fields{
+		"With": "with", "Into": "into", "Using": "using", "Condition": "condition", "Whens": "whens",
+		"Returning": "returning", "Comment": "comment",
+	}},
+	{"merge_when", stmt.MergeWhen{}, fields{"Matched": "matched", "Condition": "condition", "Action": "action"}},
+	{"merge_update_action", stmt.MergeUpdateAction{}, fields{"Set": "set"}},
+	{"merge_delete_action", stmt.MergeDeleteAction{}, fields{}},
+	{"merge_insert_action", stmt.MergeInsertAction{}, fields{"Columns": "columns", "Values": "values"}},
+	{"merge_no_action", stmt.MergeNoAction{}, fields{}},
+	{"offset", stmt.Offset{}, fields{"Start": "start"}},
+	{"on_clause", stmt.OnClause{}, fields{"Left": "left", "Right": "right"}},
+	{"infix_on_expression", stmt.InfixOnExpression{}, fields{"Left": "left", "Operator": "operator", "Right": "right"}},
+	{"logical_operator", stmt.LogicalOperator{}, fields{"Operator": "operator"}},
+	{"comparison_operator", stmt.ComparisonOperator{}, fields{"Operator": "operator"}},
+	{"arithmetic_operator", stmt.ArithmeticOperator{}, fields{"Operator": "operator"}},
+	{"order", stmt.Order{}, fields{"Expression": "expression", "Value": "value", "Type": "type"}},
+	{"order_by", stmt.OrderBy{}, fields{"Orders": "orders"}},
+	{"prefix", stmt.Prefix{}, fields{"Prefix": "prefix"}},
+	{"quantifier", stmt.Quantifier{}, fields{"Quantifier": "quantifier", "Value": "value"}},
+	{"returning", stmt.Returning{}, fields{"Columns": "columns"}},
+	{"row", stmt.Row{}, fields{"Values": "values"}},
+	{"ts_vector", stmt.TSVector{}, fields{"Config": "config", "Document": "document"}},
+	{"ts_query", stmt.TSQuery{}, fields{"Function": "function", "Config": "config", "Query": "query"}},
+	{"ts_rank", stmt.TSRank{}, fields{"Vector": "vector", "Query": "query", "Normalization": "normalization"}},
+	{"ts_headline", stmt.TSHeadline{}, fields{
+		"Config": "config", "Document": "document", "Query": "query", "Options": "options",
+	}},
+	{"select", stmt.Select{}, fields{
+		"Prefix": "prefix", "With": "with", "Distinct": "distinct", "DistinctOn": "distinct_on",
+		"Expressions": "expressions", "From": "from", "Joins": "joins", "Where": "where", "GroupBy": "group_by",
+		"Having": "having", "OrderBy": "order_by", "Limit": "limit", "Offset": "offset", "Suffix": "suffix",
+		"Comment": "comment",
+	}},
+	{"set_parameter", stmt.SetParameter{}, fields{"Name": "name", "Value": "value", "Local": "local"}},
+	{"listen", stmt.Listen{}, fields{"Channel": "channel"}},
+	{"unlisten", stmt.Unlisten{}, fields{"Channel": "channel"}},
+	{"notify", stmt.Notify{}, fields{"Channel": "channel", "Payload": "payload"}},
+	{"set", stmt.Set{}, fields{"Pairs": "pairs"}},
+	{"pair_container", stmt.PairContainer{}, fields{
+		"Mode": "mode", "Map": "map", "Columns": "columns", "Expressions": "expressions",
+	}},
+	{"exists", stmt.Exists{}, fields{"Subquery": "subquery"}},
+	{"not_exists", stmt.NotExists{}, fields{"Subquery": "subquery"}},
+	{"subquery", stmt.Subquery{}, fields{"Query": "query"}},
+	{"suffix", stmt.Suffix{}, fields{"Suffix": "suffix"}},
+	{"table", stmt.Table{}, fields{"Alias": "alias", "Name": "name", "Only": "only"}},
+	{"begin", stmt.Begin{}, fields{
+		"IsolationLevel": "isolation_level", "AccessMode": "access_mode", "Deferrable": "deferrable",
+	}},
+	{"commit", stmt.Commit{}, fields{}},
+	{"rollback", stmt.Rollback{}, fields{"Savepoint": "savepoint"}},
+	{"savepoint", stmt.Savepoint{}, fields{"Name": "name"}},
+	{"release", stmt.Release{}, fields{"Savepoint": "savepoint"}},
+	{"truncate", stmt.Truncate{}, fields{
+		"Tables": "tables", "RestartIdentity": "restart_identity", "Cascade": "cascade",
+	}},
+	{"update", stmt.Update{}, fields{
+		"With": "with", "Table": "table", "Only": "only", "From": "from", "Set": "set", "Where": "where",
+		"Returning": "returning", "Comment": "comment",
+	}},
+	{"using", stmt.Using{}, fields{"Tables": "tables"}},
+	{"values", stmt.Values{}, fields{"Values": "values"}},
+	{"where", stmt.Where{}, fields{"Condition": "condition"}},
+	{"with", stmt.With{}, fields{"Queries": "queries"}},
+	{"with_query", stmt.WithQuery{}, fields{
+		"Name": "name", "Columns": "columns", "Subquery": "subquery", "IsRecursive": "is_recursive",
+		"Materialization": "materialization",
+	}},
+	{"named_value", stmt.NamedValue{}, fields{"Name": "name", "Value": "value"}},
+}
+
+// values lists the types of values which are supported without being registered.
+var values = []struct {
+	name  string
+	value interface{}
+}{
+	{"string", ""},
+	{"bool", false},
+	{"int", int(0)},
+	{"int8", int8(0)},
+	{"int16", int16(0)},
+	{"int32", int32(0)},
+	{"int64", int64(0)},
+	{"uint", uint(0)},
+	{"uint8", uint8(0)},
+	{"uint16", uint16(0)},
+	{"uint32", uint32(0)},
+	{"uint64", uint64(0)},
+	{"float32", float32(0)},
+	{"float64", float64(0)},
+	{"[]byte", []byte{}},
+	{"[]string", []string{}},
+	{"[]int64", []int64{}},
+	{"[]interface{}", []interface{}{}},
+	{"map[string]string", map[string]string{}},
+	{"map[string]interface{}", map[string]interface{}{}},
+	{"time.Time", time.Time{}},
+	{"*wrapper", &stmt.Wrapper{}},
+	{"pq.BoolArray", pq.BoolArray{}},
+	{"*pq.BoolArray", &pq.BoolArray{}},
+	{"pq.ByteaArray", pq.ByteaArray{}},
+	{"*pq.ByteaArray", &pq.ByteaArray{}},
+	{"pq.Float64Array", pq.Float64Array{}},
+	{"*pq.Float64Array", &pq.Float64Array{}},
+	{"pq.Float32Array", pq.Float32Array{}},
+	{"*pq.Float32Array", &pq.Float32Array{}},
+	{"pq.Int64Array", pq.Int64Array{}},
+	{"*pq.Int64Array", &pq.Int64Array{}},
+	{"pq.Int32Array", pq.Int32Array{}},
+	{"*pq.Int32Array", &pq.Int32Array{}},
+	{"pq.StringArray", pq.StringArray{}},
+	{"*pq.StringArray", &pq.StringArray{}},
+}
+
+// A proxy is a struct of exported fields, used to encode a node whose fields are unexported.
+type proxy struct {
+	node   interface{}
+	value  interface{}
+	encode func(node interface{}) interface{}
+	decode func(value interface{}) interface{}
+}
+
+// proxies lists the nodes which are encoded using a proxy: their fields are the ones of the proxy.
+var proxies = []proxy{
+	{
+		node:  stmt.Table{},
+		value: tableProxy{},
+		encode: func(node interface{}) interface{} {
+			table := node.(stmt.Table)
+			return tableProxy{Alias: table.Alias, Name: table.Name, Only: table.IsOnly()}
+		},
+		decode: func(value interface{}) interface{} {
+			proxy := value.(tableProxy)
+			table := stmt.NewTableAlias(proxy.Name, proxy.Alias)
+			if proxy.Only {
+				table = table.Only()
+			}
+			return table
+		},
+	},
+	{
+		node:  stmt.Call{},
+		value: callProxy{},
+		encode: func(node interface{}) interface{} {
+			call := node.(stmt.Call)
+			return callProxy{Function: call.Function(), Args: call.Args()}
+		},
+		decode: func(value interface{}) interface{} {
+			proxy := value.(callProxy)
+			return stmt.NewCall(proxy.Function, proxy.Args...)
+		},
+	},
+}
+
+type tableProxy struct {
+	Alias string
+	Name  string
+	Only  bool
+}
+
+type callProxy struct {
+	Function string
+	Args     []stmt.Expression
+}
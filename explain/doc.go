@@ -0,0 +1,3 @@
+// Package explain decodes the JSON output of an EXPLAIN (FORMAT JSON) statement, so that tests can
+// assert on the plan chosen by PostgreSQL.
+package explain
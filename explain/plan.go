@@ -0,0 +1,94 @@
+package explain
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Result is the output of an EXPLAIN (FORMAT JSON) statement.
+type Result struct {
+	Plan          Node    `json:"Plan"`
+	PlanningTime  float64 `json:"Planning Time"`
+	ExecutionTime float64 `json:"Execution Time"`
+}
+
+// Node is a node of a query plan.
+type Node struct {
+	NodeType           string   `json:"Node Type"`
+	ParentRelationship string   `json:"Parent Relationship"`
+	RelationName       string   `json:"Relation Name"`
+	Schema             string   `json:"Schema"`
+	Alias              string   `json:"Alias"`
+	IndexName          string   `json:"Index Name"`
+	JoinType           string   `json:"Join Type"`
+	StartupCost        float64  `json:"Startup Cost"`
+	TotalCost          float64  `json:"Total Cost"`
+	PlanRows           float64  `json:"Plan Rows"`
+	PlanWidth          int64    `json:"Plan Width"`
+	ActualStartupTime  float64  `json:"Actual Startup Time"`
+	ActualTotalTime    float64  `json:"Actual Total Time"`
+	ActualRows         float64  `json:"Actual Rows"`
+	ActualLoops        float64  `json:"Actual Loops"`
+	Filter             string   `json:"Filter"`
+	IndexCond          string   `json:"Index Cond"`
+	Output             []string `json:"Output"`
+	Plans              []Node   `json:"Plans"`
+}
+
+// Parse decodes the output of an EXPLAIN (FORMAT JSON) statement.
+func Parse(data []byte) (Result, error) {
+	results := []Result{}
+
+	err := json.Unmarshal(data, &results)
+	if err != nil {
+		return Result{}, errors.Wrap(err, "loukoum: cannot decode explain output")
+	}
+	if len(results) != 1 {
+		return Result{}, errors.Errorf("loukoum: expected one plan in explain output, got %d", len(results))
+	}
+
+	return results[0], nil
+}
+
+// Walk calls given function for the node and every one of its descendants, in depth-first order.
+// Walking stops as soon as the function returns false.
+func (node Node) Walk(fn func(node Node) bool) bool {
+	if !fn(node) {
+		return false
+	}
+	for i := range node.Plans {
+		if !node.Plans[i].Walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns every node in the plan with given node type, such as "Index Scan".
+func (node Node) Find(nodeType string) []Node {
+	nodes := []Node{}
+	node.Walk(func(current Node) bool {
+		if current.NodeType == nodeType {
+			nodes = append(nodes, current)
+		}
+		return true
+	})
+	return nodes
+}
+
+// Uses returns true if the plan has a node with given node type.
+func (node Node) Uses(nodeType string) bool {
+	return len(node.Find(nodeType)) > 0
+}
+
+// UsesIndex returns true if the plan scans given index, using an index scan, an index only scan or
+// a bitmap index scan.
+func (node Node) UsesIndex(index string) bool {
+	found := false
+	node.Walk(func(current Node) bool {
+		found = current.IndexName == index
+		return !found
+	})
+	return found
+}
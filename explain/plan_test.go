@@ -0,0 +1,82 @@
+package explain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3/explain"
+)
+
+func TestParse(t *testing.T) {
+	is := require.New(t)
+
+	output := []byte(`[
+	  {
+	    "Plan": {
+	      "Node Type": "Nested Loop",
+	      "Join Type": "Inner",
+	      "Startup Cost": 0.29,
+	      "Total Cost": 16.34,
+	      "Plan Rows": 1,
+	      "Plan Width": 8,
+	      "Actual Startup Time": 0.021,
+	      "Actual Total Time": 0.023,
+	      "Actual Rows": 1,
+	      "Actual Loops": 1,
+	      "Plans": [
+	        {
+	          "Node Type": "Index Scan",
+	          "Parent Relationship": "Outer",
+	          "Relation Name": "users",
+	          "Alias": "users",
+	          "Index Name": "users_pkey",
+	          "Startup Cost": 0.15,
+	          "Total Cost": 8.17,
+	          "Plan Rows": 1,
+	          "Plan Width": 8,
+	          "Index Cond": "(id = 1)"
+	        },
+	        {
+	          "Node Type": "Seq Scan",
+	          "Parent Relationship": "Inner",
+	          "Relation Name": "comments",
+	          "Alias": "comments",
+	          "Startup Cost": 0.00,
+	          "Total Cost": 8.15,
+	          "Plan Rows": 1,
+	          "Plan Width": 8,
+	          "Filter": "(user_id = 1)"
+	        }
+	      ]
+	    },
+	    "Planning Time": 0.128,
+	    "Execution Time": 0.051
+	  }
+	]`)
+
+	result, err := explain.Parse(output)
+	is.NoError(err)
+	is.Equal(0.128, result.PlanningTime)
+	is.Equal(0.051, result.ExecutionTime)
+	is.Equal("Nested Loop", result.Plan.NodeType)
+	is.Equal(16.34, result.Plan.TotalCost)
+	is.Equal(0.023, result.Plan.ActualTotalTime)
+	is.Len(result.Plan.Plans, 2)
+
+	scans := result.Plan.Find("Index Scan")
+	is.Len(scans, 1)
+	is.Equal("users", scans[0].RelationName)
+	is.Equal("(id = 1)", scans[0].IndexCond)
+
+	is.True(result.Plan.Uses("Seq Scan"))
+	is.False(result.Plan.Uses("Hash Join"))
+	is.True(result.Plan.UsesIndex("users_pkey"))
+	is.False(result.Plan.UsesIndex("comments_pkey"))
+
+	_, err = explain.Parse([]byte(`{"Plan": {}}`))
+	is.Error(err)
+
+	_, err = explain.Parse([]byte(`[]`))
+	is.Error(err)
+}
@@ -0,0 +1,90 @@
+package filter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// EncodeCursor returns a keyset cursor for the page[after] parameter, from the values of the sorted
+// fields of the last row of a page, in the same order than the sort parameter.
+// Values must not be null.
+func EncodeCursor(values ...interface{}) string {
+	list := make([]string, len(values))
+	for i := range values {
+		switch value := values[i].(type) {
+		case time.Time:
+			list[i] = value.Format(time.RFC3339Nano)
+		default:
+			list[i] = fmt.Sprint(value)
+		}
+	}
+
+	// Marshaling a slice of strings never fails.
+	data, _ := json.Marshal(list)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []string{}
+	err = json.Unmarshal(data, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// condition returns the condition selecting the rows after given cursor.
+// Rows are compared with a row constructor, so every sorted field must use the same direction.
+func (keyset keyset) condition(cursor string) (stmt.Expression, error) {
+	if len(keyset.fields) == 0 {
+		return nil, newError(PageAfterParameter, ErrInvalidCursor, "a sort is required")
+	}
+	if keyset.mixed {
+		return nil, newError(PageAfterParameter, ErrInvalidCursor, "sorted fields must use the same direction")
+	}
+
+	list, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, newError(PageAfterParameter, ErrInvalidCursor, "%s", err)
+	}
+	if len(list) != len(keyset.fields) {
+		return nil, newError(PageAfterParameter, ErrInvalidCursor,
+			"expected %d values, got %d", len(keyset.fields), len(list))
+	}
+
+	columns := make([]stmt.Expression, len(list))
+	values := make([]interface{}, len(list))
+	for i := range list {
+		value, err := parseValue(keyset.fields[i].Type, list[i])
+		if err != nil {
+			return nil, newError(PageAfterParameter, ErrInvalidCursor, "%s", err)
+		}
+		columns[i] = stmt.NewIdentifier(columnName(keyset.names[i], keyset.fields[i]))
+		values[i] = value
+	}
+
+	if len(columns) == 1 {
+		column := columns[0].(stmt.Identifier)
+		if keyset.kind == types.Desc {
+			return column.LessThan(values[0]), nil
+		}
+		return column.GreaterThan(values[0]), nil
+	}
+
+	row := stmt.NewRow(columns...)
+	if keyset.kind == types.Desc {
+		return row.LessThan(stmt.NewValueRow(values...)), nil
+	}
+	return row.GreaterThan(stmt.NewValueRow(values...)), nil
+}
@@ -0,0 +1,33 @@
+// Package filter translates the query parameters of a list endpoint into loukoum conditions, orders and
+// pagination clauses, using a whitelist of filterable and sortable fields.
+//
+// Given the following schema:
+//
+//	schema := filter.Schema{
+//		Fields: map[string]filter.Field{
+//			"status":     {Type: filter.String},
+//			"created_at": {Type: filter.Time, Sortable: true},
+//		},
+//		MaxSize: 100,
+//	}
+//
+// The query string "status=published&created_at__gte=2024-01-01&sort=-created_at&page[size]=20" is
+// translated to:
+//
+//	WHERE (("created_at" >= $1) AND ("status" = $2)) ORDER BY "created_at" DESC LIMIT 20
+//
+// A filter is either "field=value", using an equal comparison, or "field__operator=value".
+// Other parameters are ignored, unless the schema is strict.
+// The "in" and "nin" operators expect a comma-separated list of values, and "between" two of them.
+//
+// Pagination uses either "page[size]" and "page[number]", which are translated to a LIMIT and OFFSET
+// clauses, or "page[size]" and "page[after]", which uses a keyset cursor returned by EncodeCursor.
+//
+// The same schema also decodes filters given as a JSON tree, using Decode, and encodes expressions back
+// to a JSON tree, using Encode:
+//
+//	{"and": [
+//		{"field": "status", "op": "in", "value": ["a", "b"]},
+//		{"not": {"field": "title", "op": "null", "value": true}}
+//	]}
+package filter
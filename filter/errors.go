@@ -0,0 +1,59 @@
+package filter
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrUnknownField is returned when a parameter references a field which is not in the schema.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrInvalidValue is returned when a value cannot be parsed using the type of its field.
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrOperatorNotAllowed is returned when an operator is unknown or not allowed for a field.
+	ErrOperatorNotAllowed = errors.New("operator not allowed")
+	// ErrNotSortable is returned when a sort parameter references a field which is not sortable.
+	ErrNotSortable = errors.New("field is not sortable")
+	// ErrInvalidPage is returned when a pagination parameter is invalid.
+	ErrInvalidPage = errors.New("invalid page")
+	// ErrInvalidCursor is returned when a keyset cursor cannot be decoded or used.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidNode is returned when a node of a filter tree is malformed or cannot be encoded.
+	ErrInvalidNode = errors.New("invalid node")
+)
+
+// Error is a validation error of a query parameter, or of a node of a filter tree.
+// For a filter tree, Parameter is the path of the node, such as "and[1].or[0].status".
+// Its cause is one of the Err* variables of this package.
+type Error struct {
+	Parameter string
+	Reason    string
+	Err       error
+}
+
+func newError(parameter string, err error, reason string, args ...interface{}) *Error {
+	return &Error{
+		Parameter: parameter,
+		Reason:    fmt.Sprintf(reason, args...),
+		Err:       err,
+	}
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("loukoum: invalid parameter %q: %s", e.Parameter, e.Err)
+	}
+	return fmt.Sprintf("loukoum: invalid parameter %q: %s: %s", e.Parameter, e.Err, e.Reason)
+}
+
+// Cause returns the underlying error, for errors.Cause.
+func (e *Error) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error, for errors.Is.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,460 @@
+package filter
+
+import (
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ulule/loukoum/v3/builder"
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Reserved parameters.
+const (
+	SortParameter       = "sort"
+	PageSizeParameter   = "page[size]"
+	PageNumberParameter = "page[number]"
+	PageAfterParameter  = "page[after]"
+)
+
+// Type is the type of a field, used to parse its values.
+type Type int
+
+// Field types.
+const (
+	String Type = iota
+	Integer
+	Float
+	Boolean
+	Time
+)
+
+// String returns the type name.
+func (kind Type) String() string {
+	switch kind {
+	case Integer:
+		return "integer"
+	case Float:
+		return "float"
+	case Boolean:
+		return "boolean"
+	case Time:
+		return "time"
+	default:
+		return "string"
+	}
+}
+
+// Operator is a comparison operator, given as a suffix of a parameter name: "field__operator=value".
+type Operator string
+
+// Operators.
+const (
+	Equal              = Operator("eq")
+	NotEqual           = Operator("ne")
+	GreaterThan        = Operator("gt")
+	GreaterThanOrEqual = Operator("gte")
+	LessThan           = Operator("lt")
+	LessThanOrEqual    = Operator("lte")
+	In                 = Operator("in")
+	NotIn              = Operator("nin")
+	Between            = Operator("between")
+	IsNull             = Operator("null")
+	Like               = Operator("like")
+	ILike              = Operator("ilike")
+	Contains           = Operator("contains")
+	IContains          = Operator("icontains")
+	StartsWith         = Operator("startswith")
+	EndsWith           = Operator("endswith")
+)
+
+// Field is a filterable field.
+type Field struct {
+	// Column is the column name used in the query. The field name is used if it's empty.
+	Column string
+	// Type is used to parse the values of the field.
+	Type Type
+	// Operators are the allowed operators. Every operator supported by the type is allowed if it's empty.
+	Operators []Operator
+	// Sortable defines if the field can be used in a sort parameter.
+	Sortable bool
+}
+
+func (field Field) allows(operator Operator) bool {
+	operators := field.Operators
+	if len(operators) == 0 {
+		operators = defaultOperators(field.Type)
+	}
+	for i := range operators {
+		if operators[i] == operator {
+			return field.supports(operator)
+		}
+	}
+	return false
+}
+
+func (field Field) supports(operator Operator) bool {
+	switch operator {
+	case Contains, IContains, StartsWith, EndsWith, Like, ILike:
+		return field.Type == String
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, Between:
+		return field.Type != Boolean
+	}
+	return true
+}
+
+func defaultOperators(kind Type) []Operator {
+	operators := []Operator{Equal, NotEqual, In, NotIn, IsNull}
+	if kind != Boolean {
+		operators = append(operators, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, Between)
+	}
+	if kind == String {
+		operators = append(operators, Contains, IContains, StartsWith, EndsWith, Like, ILike)
+	}
+	return operators
+}
+
+// Schema is the whitelist of fields of a list endpoint.
+type Schema struct {
+	Fields map[string]Field
+	// DefaultSort is used when the sort parameter is missing, for example "-created_at".
+	DefaultSort string
+	// DefaultSize is the page size used when the page[size] parameter is missing. Zero means no limit.
+	DefaultSize int64
+	// MaxSize is the maximum page size. Zero means no maximum.
+	MaxSize int64
+	// Strict rejects the parameters which are not a field of the schema. By default, they are ignored, so that
+	// a query string can contain other parameters.
+	Strict bool
+}
+
+// Query is the result of parsed query parameters.
+type Query struct {
+	Conditions []stmt.Expression
+	Orders     []stmt.Order
+	Limit      int64
+	Offset     int64
+}
+
+// Condition returns the conditions joined with an AND operator.
+// It's empty if there is no condition.
+func (query Query) Condition() stmt.Expression {
+	return stmt.NewLogicalExpression(types.And, query.Conditions...)
+}
+
+// Apply adds the conditions, orders and pagination clauses to given select builder.
+func (query Query) Apply(b builder.Select) builder.Select {
+	condition := query.Condition()
+	if !condition.IsEmpty() {
+		b = b.Where(condition)
+	}
+	if len(query.Orders) > 0 {
+		b = b.OrderBy(query.Orders...)
+	}
+	if query.Limit > 0 {
+		b = b.Limit(query.Limit)
+	}
+	if query.Offset > 0 {
+		b = b.Offset(query.Offset)
+	}
+	return b
+}
+
+// Parse translates given query parameters.
+// It returns an *Error if a parameter is invalid, or if it's unknown and the schema is strict.
+func (schema Schema) Parse(values url.Values) (Query, error) {
+	query := Query{}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch key {
+		case SortParameter, PageSizeParameter, PageNumberParameter, PageAfterParameter:
+			if len(values[key]) != 1 {
+				return Query{}, newError(key, ErrInvalidValue, "parameter must be given once")
+			}
+			continue
+		}
+
+		_, _, _, err := schema.lookup(key)
+		if errors.Cause(err) == ErrUnknownField && !schema.Strict {
+			continue
+		}
+		if len(values[key]) != 1 {
+			return Query{}, newError(key, ErrInvalidValue, "parameter must be given once")
+		}
+
+		condition, err := schema.parseCondition(key, values.Get(key))
+		if err != nil {
+			return Query{}, err
+		}
+		query.Conditions = append(query.Conditions, condition)
+	}
+
+	sorts := schema.DefaultSort
+	if _, ok := values[SortParameter]; ok {
+		sorts = values.Get(SortParameter)
+	}
+	keyset, err := schema.parseSort(&query, sorts)
+	if err != nil {
+		return Query{}, err
+	}
+
+	err = schema.parsePage(&query, values, keyset)
+	if err != nil {
+		return Query{}, err
+	}
+
+	return query, nil
+}
+
+func (schema Schema) lookup(parameter string) (string, Field, Operator, error) {
+	field, ok := schema.Fields[parameter]
+	if ok {
+		if !field.allows(Equal) {
+			return "", Field{}, "", newError(parameter, ErrOperatorNotAllowed, "%q", Equal)
+		}
+		return parameter, field, Equal, nil
+	}
+
+	i := strings.LastIndex(parameter, "__")
+	if i == -1 {
+		return "", Field{}, "", newError(parameter, ErrUnknownField, "")
+	}
+
+	name := parameter[:i]
+	operator := Operator(parameter[i+2:])
+
+	field, ok = schema.Fields[name]
+	if !ok {
+		return "", Field{}, "", newError(parameter, ErrUnknownField, "")
+	}
+	if !field.allows(operator) {
+		return "", Field{}, "", newError(parameter, ErrOperatorNotAllowed, "%q", operator)
+	}
+
+	return name, field, operator, nil
+}
+
+func (schema Schema) parseCondition(parameter string, value string) (stmt.Expression, error) {
+	name, field, operator, err := schema.lookup(parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{}
+
+	switch operator {
+	case IsNull:
+		null, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, newError(parameter, ErrInvalidValue, "expected a boolean")
+		}
+		args = append(args, null)
+
+	case In, NotIn, Between:
+		list := strings.Split(value, ",")
+		if operator == Between && len(list) != 2 {
+			return nil, newError(parameter, ErrInvalidValue, "expected two comma-separated values")
+		}
+		for _, element := range list {
+			arg, err := parseValue(field.Type, element)
+			if err != nil {
+				return nil, newError(parameter, ErrInvalidValue, "%s", err)
+			}
+			args = append(args, arg)
+		}
+
+	default:
+		arg, err := parseValue(field.Type, value)
+		if err != nil {
+			return nil, newError(parameter, ErrInvalidValue, "%s", err)
+		}
+		args = append(args, arg)
+	}
+
+	return newCondition(stmt.NewIdentifier(columnName(name, field)), operator, args), nil
+}
+
+// newCondition returns the condition of given operator, using arguments already coerced to the field type:
+// a boolean for null, a list of values for in and nin, two bounds for between and a single value otherwise.
+func newCondition(column stmt.Identifier, operator Operator, args []interface{}) stmt.Expression { // nolint: gocyclo
+	switch operator {
+	case IsNull:
+		return column.IsNull(args[0].(bool))
+	case In:
+		return column.In(args...)
+	case NotIn:
+		return column.NotIn(args...)
+	case Between:
+		return column.Between(args[0], args[1])
+	case Contains:
+		return column.ContainsText(args[0].(string))
+	case IContains:
+		return column.ILike("%" + stmt.EscapeLike(args[0].(string)) + "%")
+	case StartsWith:
+		return column.StartsWith(args[0].(string))
+	case EndsWith:
+		return column.EndsWith(args[0].(string))
+	case Like:
+		return column.Like(args[0])
+	case ILike:
+		return column.ILike(args[0])
+	case NotEqual:
+		return column.NotEqual(args[0])
+	case GreaterThan:
+		return column.GreaterThan(args[0])
+	case GreaterThanOrEqual:
+		return column.GreaterThanOrEqual(args[0])
+	case LessThan:
+		return column.LessThan(args[0])
+	case LessThanOrEqual:
+		return column.LessThanOrEqual(args[0])
+	default:
+		return column.Equal(args[0])
+	}
+}
+
+// keyset is the list of sorted fields, used to build a keyset cursor condition.
+type keyset struct {
+	fields []Field
+	names  []string
+	kind   types.OrderType
+	mixed  bool
+}
+
+func (schema Schema) parseSort(query *Query, value string) (keyset, error) {
+	result := keyset{}
+	if value == "" {
+		return result, nil
+	}
+
+	for i, name := range strings.Split(value, ",") {
+		kind := types.Asc
+		if strings.HasPrefix(name, "-") {
+			kind = types.Desc
+			name = name[1:]
+		}
+
+		field, ok := schema.Fields[name]
+		if !ok {
+			return keyset{}, newError(SortParameter, ErrUnknownField, "%q", name)
+		}
+		if !field.Sortable {
+			return keyset{}, newError(SortParameter, ErrNotSortable, "%q", name)
+		}
+
+		if i != 0 && kind != result.kind {
+			result.mixed = true
+		}
+		result.kind = kind
+		result.fields = append(result.fields, field)
+		result.names = append(result.names, name)
+
+		column := stmt.NewIdentifier(columnName(name, field))
+		query.Orders = append(query.Orders, stmt.NewExpressionOrder(column, kind))
+	}
+
+	return result, nil
+}
+
+func (schema Schema) parsePage(query *Query, values url.Values, keyset keyset) error {
+	size := schema.DefaultSize
+	if _, ok := values[PageSizeParameter]; ok {
+		value, err := parsePageParameter(PageSizeParameter, values.Get(PageSizeParameter))
+		if err != nil {
+			return err
+		}
+		if schema.MaxSize > 0 && value > schema.MaxSize {
+			return newError(PageSizeParameter, ErrInvalidPage, "maximum is %d", schema.MaxSize)
+		}
+		size = value
+	}
+	query.Limit = size
+
+	_, hasNumber := values[PageNumberParameter]
+	_, hasAfter := values[PageAfterParameter]
+
+	switch {
+	case hasNumber && hasAfter:
+		return newError(PageAfterParameter, ErrInvalidPage, "cannot be used with %s", PageNumberParameter)
+
+	case hasNumber:
+		number, err := parsePageParameter(PageNumberParameter, values.Get(PageNumberParameter))
+		if err != nil {
+			return err
+		}
+		if size == 0 {
+			return newError(PageNumberParameter, ErrInvalidPage, "a page size is required")
+		}
+		if number-1 > math.MaxInt64/size {
+			return newError(PageNumberParameter, ErrInvalidPage, "offset is out of range")
+		}
+		query.Offset = (number - 1) * size
+
+	case hasAfter:
+		condition, err := keyset.condition(values.Get(PageAfterParameter))
+		if err != nil {
+			return err
+		}
+		query.Conditions = append(query.Conditions, condition)
+	}
+
+	return nil
+}
+
+// parsePageParameter parses a positive integer of a pagination parameter, such as a page size.
+func parsePageParameter(parameter string, value string) (int64, error) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, newError(parameter, ErrInvalidPage, "value is out of range")
+	}
+	if err != nil || number <= 0 {
+		return 0, newError(parameter, ErrInvalidPage, "expected a positive integer")
+	}
+	return number, nil
+}
+
+func columnName(name string, field Field) string {
+	if field.Column != "" {
+		return field.Column
+	}
+	return name
+}
+
+func parseValue(kind Type, value string) (interface{}, error) {
+	var (
+		arg interface{}
+		err error
+	)
+
+	switch kind {
+	case Integer:
+		arg, err = strconv.ParseInt(value, 10, 64)
+	case Float:
+		arg, err = strconv.ParseFloat(value, 64)
+	case Boolean:
+		arg, err = strconv.ParseBool(value)
+	case Time:
+		arg, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			arg, err = time.Parse("2006-01-02", value)
+		}
+	default:
+		arg = value
+	}
+	if err != nil {
+		return nil, errors.Errorf("cannot parse %q as %s", value, kind)
+	}
+
+	return arg, nil
+}
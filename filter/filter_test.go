@@ -0,0 +1,140 @@
+package filter_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/filter"
+)
+
+var schema = filter.Schema{
+	Fields: map[string]filter.Field{
+		"status":     {Type: filter.String, Operators: []filter.Operator{filter.Equal, filter.In}},
+		"title":      {Type: filter.String},
+		"views":      {Type: filter.Integer, Sortable: true},
+		"published":  {Type: filter.Boolean, Column: "is_published"},
+		"created_at": {Type: filter.Time, Sortable: true},
+		"id":         {Type: filter.Integer, Sortable: true},
+	},
+	DefaultSort: "-created_at",
+	MaxSize:     100,
+}
+
+func parse(t *testing.T, query string) (string, []interface{}) {
+	is := require.New(t)
+
+	values, err := url.ParseQuery(query)
+	is.NoError(err)
+
+	result, err := schema.Parse(values)
+	is.NoError(err)
+
+	return result.Apply(loukoum.Select("id").From("posts")).Query()
+}
+
+func TestParse(t *testing.T) {
+	is := require.New(t)
+
+	query, args := parse(t, "status=published&created_at__gte=2024-01-01&sort=-created_at&page[size]=20")
+	is.Equal(`SELECT "id" FROM "posts" WHERE (("created_at" >= $1) AND ("status" = $2)) `+
+		`ORDER BY "created_at" DESC LIMIT 20`, query)
+	is.Equal([]interface{}{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "published"}, args)
+
+	query, args = parse(t, "published=true&views__lt=10&title__icontains=50%25&sort=views,id&page[size]=10&page[number]=3")
+	is.Equal(`SELECT "id" FROM "posts" WHERE ((("is_published" = $1) AND ("title" ILIKE $2)) AND ("views" < $3)) `+
+		`ORDER BY "views" ASC, "id" ASC LIMIT 10 OFFSET 20`, query)
+	is.Equal([]interface{}{true, `%50\%%`, int64(10)}, args)
+
+	query, args = parse(t, "status__in=draft,published&published__null=false")
+	is.Equal(`SELECT "id" FROM "posts" WHERE (("is_published" IS NOT NULL) AND ("status" IN ($1, $2))) `+
+		`ORDER BY "created_at" DESC`, query)
+	is.Equal([]interface{}{"draft", "published"}, args)
+
+	query, args = parse(t, "")
+	is.Equal(`SELECT "id" FROM "posts" ORDER BY "created_at" DESC`, query)
+	is.Empty(args)
+
+	// Parameters which are not fields are ignored, even if they are given several times.
+	query, args = parse(t, "status=draft&page=2&utm_source=a&utm_source=b&author__eq=john")
+	is.Equal(`SELECT "id" FROM "posts" WHERE ("status" = $1) ORDER BY "created_at" DESC`, query)
+	is.Equal([]interface{}{"draft"}, args)
+}
+
+func TestParse_Cursor(t *testing.T) {
+	is := require.New(t)
+
+	createdAt := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	query, args := parse(t, url.Values{
+		"page[size]":  {"20"},
+		"page[after]": {filter.EncodeCursor(createdAt)},
+	}.Encode())
+	is.Equal(`SELECT "id" FROM "posts" WHERE ("created_at" < $1) ORDER BY "created_at" DESC LIMIT 20`, query)
+	is.Equal([]interface{}{createdAt}, args)
+
+	query, args = parse(t, url.Values{
+		"sort":        {"views,id"},
+		"page[after]": {filter.EncodeCursor(42, int64(1337))},
+	}.Encode())
+	is.Equal(`SELECT "id" FROM "posts" WHERE (("views", "id") > ($1, $2)) ORDER BY "views" ASC, "id" ASC`, query)
+	is.Equal([]interface{}{int64(42), int64(1337)}, args)
+}
+
+func TestParse_Errors(t *testing.T) {
+	is := require.New(t)
+
+	scenarios := []struct {
+		query     string
+		parameter string
+		err       error
+	}{
+		{"author=john", "author", filter.ErrUnknownField},
+		{"author__eq=john", "author__eq", filter.ErrUnknownField},
+		{"views=abc", "views", filter.ErrInvalidValue},
+		{"views__in=1,b", "views__in", filter.ErrInvalidValue},
+		{"created_at__gt=yesterday", "created_at__gt", filter.ErrInvalidValue},
+		{"views=1&views=2", "views", filter.ErrInvalidValue},
+		{"status__ne=draft", "status__ne", filter.ErrOperatorNotAllowed},
+		{"views__contains=1", "views__contains", filter.ErrOperatorNotAllowed},
+		{"published__gt=true", "published__gt", filter.ErrOperatorNotAllowed},
+		{"title__regex=a", "title__regex", filter.ErrOperatorNotAllowed},
+		{"sort=title", "sort", filter.ErrNotSortable},
+		{"sort=-author", "sort", filter.ErrUnknownField},
+		{"page[size]=0", "page[size]", filter.ErrInvalidPage},
+		{"page[size]=-10", "page[size]", filter.ErrInvalidPage},
+		{"page[size]=9223372036854775808", "page[size]", filter.ErrInvalidPage},
+		{"page[size]=10&page[number]=-1", "page[number]", filter.ErrInvalidPage},
+		{"page[size]=10&page[number]=9223372036854775807", "page[number]", filter.ErrInvalidPage},
+		{"page[size]=10&page[number]=99999999999999999999", "page[number]", filter.ErrInvalidPage},
+		{"sort=views&sort=id", "sort", filter.ErrInvalidValue},
+		{"page[size]=1000", "page[size]", filter.ErrInvalidPage},
+		{"page[number]=2", "page[number]", filter.ErrInvalidPage},
+		{"page[size]=10&page[number]=0", "page[number]", filter.ErrInvalidPage},
+		{"page[number]=1&page[after]=abc", "page[after]", filter.ErrInvalidPage},
+		{"page[after]=abc", "page[after]", filter.ErrInvalidCursor},
+		{"page[after]=" + filter.EncodeCursor(1, 2), "page[after]", filter.ErrInvalidCursor},
+		{"sort=views,-id&page[after]=" + filter.EncodeCursor(1, 2), "page[after]", filter.ErrInvalidCursor},
+		{"sort=views&page[after]=" + filter.EncodeCursor("abc"), "page[after]", filter.ErrInvalidCursor},
+	}
+
+	strict := schema
+	strict.Strict = true
+
+	for _, scenario := range scenarios {
+		values, err := url.ParseQuery(scenario.query)
+		is.NoError(err)
+
+		_, err = strict.Parse(values)
+		is.Error(err, scenario.query)
+		is.Equal(scenario.err, errors.Cause(err), scenario.query)
+
+		e, ok := err.(*filter.Error)
+		is.True(ok, scenario.query)
+		is.Equal(scenario.parameter, e.Parameter, scenario.query)
+	}
+}
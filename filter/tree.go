@@ -0,0 +1,446 @@
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/ulule/loukoum/v3/stmt"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Node is a node of a filter tree, such as:
+//
+//	{"and": [{"field": "status", "op": "in", "value": ["a", "b"]}, {"or": [...]}]}
+//
+// A node is either a logical node, using one of And, Or or Not, or a condition on a field.
+// The operator of a condition defaults to "eq".
+type Node struct {
+	And      []Node          `json:"and,omitempty"`
+	Or       []Node          `json:"or,omitempty"`
+	Not      *Node           `json:"not,omitempty"`
+	Field    string          `json:"field,omitempty"`
+	Operator Operator        `json:"op,omitempty"`
+	Value    json.RawMessage `json:"value,omitempty"`
+}
+
+// Decode converts given JSON filter tree into an expression.
+// It returns an *Error if a node is malformed, or if a field, an operator or a value is invalid.
+func (schema Schema) Decode(data []byte) (stmt.Expression, error) {
+	node := Node{}
+
+	err := json.Unmarshal(data, &node)
+	if err != nil {
+		return nil, newError("", ErrInvalidNode, "%s", err)
+	}
+
+	return schema.Expression(node)
+}
+
+// Expression converts given filter tree into an expression.
+func (schema Schema) Expression(node Node) (stmt.Expression, error) {
+	return schema.expression(node, "")
+}
+
+func (schema Schema) expression(node Node, path string) (stmt.Expression, error) { // nolint: gocyclo
+	count := 0
+	for _, ok := range []bool{node.And != nil, node.Or != nil, node.Not != nil, node.Field != ""} {
+		if ok {
+			count++
+		}
+	}
+	if count != 1 {
+		return nil, newError(path, ErrInvalidNode, "expected one of and, or, not or field")
+	}
+
+	switch {
+	case node.And != nil:
+		return schema.logical(types.And, node.And, join(path, "and"))
+	case node.Or != nil:
+		return schema.logical(types.Or, node.Or, join(path, "or"))
+	case node.Not != nil:
+		expression, err := schema.expression(*node.Not, join(path, "not"))
+		if err != nil {
+			return nil, err
+		}
+		return stmt.NewNot(expression), nil
+	}
+
+	path = join(path, node.Field)
+
+	field, ok := schema.Fields[node.Field]
+	if !ok {
+		return nil, newError(path, ErrUnknownField, "")
+	}
+
+	operator := node.Operator
+	if operator == "" {
+		operator = Equal
+	}
+	if !field.allows(operator) {
+		return nil, newError(path, ErrOperatorNotAllowed, "%q", operator)
+	}
+
+	args, err := decodeArguments(field.Type, operator, node.Value)
+	if err != nil {
+		return nil, newError(path, ErrInvalidValue, "%s", err)
+	}
+
+	return newCondition(stmt.NewIdentifier(columnName(node.Field, field)), operator, args), nil
+}
+
+func (schema Schema) logical(operator types.LogicalOperator, nodes []Node, path string) (stmt.Expression, error) {
+	if len(nodes) == 0 {
+		return nil, newError(path, ErrInvalidNode, "expected at least one condition")
+	}
+
+	conditions := make([]stmt.Expression, len(nodes))
+	for i := range nodes {
+		condition, err := schema.expression(nodes[i], fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		conditions[i] = condition
+	}
+
+	return stmt.NewLogicalExpression(operator, conditions...), nil
+}
+
+func decodeArguments(kind Type, operator Operator, data json.RawMessage) ([]interface{}, error) {
+	var raw interface{}
+	if len(data) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err := decoder.Decode(&raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch operator {
+	case IsNull:
+		null, ok := raw.(bool)
+		if !ok {
+			return nil, errors.New("expected a boolean")
+		}
+		return []interface{}{null}, nil
+
+	case In, NotIn, Between:
+		list, ok := raw.([]interface{})
+		if !ok {
+			return nil, errors.New("expected a list of values")
+		}
+		if operator == Between && len(list) != 2 {
+			return nil, errors.New("expected two values")
+		}
+		args := make([]interface{}, len(list))
+		for i := range list {
+			arg, err := coerceValue(kind, list[i])
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+		}
+		return args, nil
+
+	default:
+		arg, err := coerceValue(kind, raw)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{arg}, nil
+	}
+}
+
+func coerceValue(kind Type, raw interface{}) (interface{}, error) {
+	switch value := raw.(type) {
+	case string:
+		return parseValue(kind, value)
+	case json.Number:
+		if kind == Integer || kind == Float {
+			return parseValue(kind, value.String())
+		}
+	case bool:
+		if kind == Boolean {
+			return value, nil
+		}
+	}
+	return nil, errors.Errorf("expected a %s", kind)
+}
+
+// Encode converts given expression into a JSON filter tree.
+// Only the expressions which can be decoded using the schema are supported.
+func (schema Schema) Encode(expression stmt.Expression) ([]byte, error) {
+	node, err := schema.Node(expression)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+// Node converts given expression into a filter tree.
+// Only the expressions which can be decoded using the schema are supported.
+func (schema Schema) Node(expression stmt.Expression) (Node, error) {
+	return schema.node(expression, "")
+}
+
+func (schema Schema) node(expression stmt.Expression, path string) (Node, error) { // nolint: gocyclo
+	switch value := expression.(type) {
+	case *stmt.Wrapper:
+		return schema.node(value.Value, path)
+	case stmt.Wrapper:
+		return schema.node(value.Value, path)
+
+	case stmt.Unary:
+		if value.Operator != types.Not.String()+" " {
+			break
+		}
+		node, err := schema.node(value.Value, join(path, "not"))
+		if err != nil {
+			return Node{}, err
+		}
+		return Node{Not: &node}, nil
+
+	case stmt.InfixExpression:
+		operator, ok := value.Operator.(stmt.LogicalOperator)
+		if ok {
+			return schema.logicalNode(operator.Operator, value, path)
+		}
+		comparison, ok := value.Operator.(stmt.ComparisonOperator)
+		if ok {
+			return schema.comparisonNode(value.Left, comparison.Operator, value.Right, path)
+		}
+
+	case stmt.In:
+		operator := In
+		if value.Operator.Operator == types.NotIn {
+			operator = NotIn
+		}
+		array, ok := value.Value.(stmt.Array)
+		if !ok {
+			break
+		}
+		return schema.conditionNode(value.Expression, operator, array.Values, path)
+
+	case stmt.Between:
+		node, err := schema.conditionNode(value.Identifier, Between, []stmt.Expression{value.From, value.To}, path)
+		if err != nil {
+			return Node{}, err
+		}
+		if value.Operator.Operator == types.NotBetween {
+			return Node{Not: &node}, nil
+		}
+		return node, nil
+	}
+
+	return Node{}, newError(path, ErrInvalidNode, "unsupported expression %T", expression)
+}
+
+func (schema Schema) logicalNode(operator types.LogicalOperator, expression stmt.InfixExpression,
+	path string) (Node, error) {
+
+	nodes := []Node{}
+	for _, operand := range []stmt.Expression{expression.Left, expression.Right} {
+		node, err := schema.node(operand, path)
+		if err != nil {
+			return Node{}, err
+		}
+
+		// Nested expressions using the same operator are flattened.
+		switch {
+		case operator == types.And && node.And != nil:
+			nodes = append(nodes, node.And...)
+		case operator == types.Or && node.Or != nil:
+			nodes = append(nodes, node.Or...)
+		default:
+			nodes = append(nodes, node)
+		}
+	}
+
+	if operator == types.And {
+		return Node{And: nodes}, nil
+	}
+	return Node{Or: nodes}, nil
+}
+
+var comparisonOperators = map[types.ComparisonOperator]Operator{
+	types.Equal:              Equal,
+	types.NotEqual:           NotEqual,
+	types.GreaterThan:        GreaterThan,
+	types.GreaterThanOrEqual: GreaterThanOrEqual,
+	types.LessThan:           LessThan,
+	types.LessThanOrEqual:    LessThanOrEqual,
+	types.Like:               Like,
+	types.ILike:              ILike,
+}
+
+func (schema Schema) comparisonNode(left stmt.Expression, operator types.ComparisonOperator,
+	right stmt.Expression, path string) (Node, error) {
+
+	value, ok := right.(stmt.Value)
+	if ok && value.Value == nil && (operator == types.Is || operator == types.IsNot) {
+		name, err := schema.fieldName(left, path)
+		if err != nil {
+			return Node{}, err
+		}
+		return schema.leafNode(name, IsNull, operator == types.Is, path)
+	}
+
+	kind, ok := comparisonOperators[operator]
+	if !ok {
+		return Node{}, newError(path, ErrInvalidNode, "unsupported operator %q", operator)
+	}
+
+	if kind == Like || kind == ILike {
+		return schema.likeNode(left, kind, right, path)
+	}
+
+	return schema.conditionNode(left, kind, []stmt.Expression{right}, path)
+}
+
+// likeNode converts a LIKE or ILIKE condition into a filter tree, using the operator which produced its
+// pattern, such as "contains" for "%...%", if the field allows it.
+func (schema Schema) likeNode(left stmt.Expression, operator Operator, right stmt.Expression,
+	path string) (Node, error) {
+
+	value, ok := right.(stmt.Value)
+	if !ok {
+		return schema.conditionNode(left, operator, []stmt.Expression{right}, path)
+	}
+	pattern, ok := value.Value.(string)
+	if !ok {
+		return schema.conditionNode(left, operator, []stmt.Expression{right}, path)
+	}
+
+	name, err := schema.fieldName(left, path)
+	if err != nil {
+		return Node{}, err
+	}
+
+	text, kind, ok := unescapeLike(pattern, operator)
+	if !ok || !schema.Fields[name].allows(kind) {
+		return schema.leafNode(name, operator, pattern, path)
+	}
+	return schema.leafNode(name, kind, text, path)
+}
+
+// unescapeLike returns the text and the operator of given pattern, if it was built by escaping the text and
+// adding a leading and/or a trailing wildcard.
+func unescapeLike(pattern string, operator Operator) (string, Operator, bool) {
+	prefix := strings.HasPrefix(pattern, "%")
+	if prefix {
+		pattern = pattern[1:]
+	}
+
+	suffix := false
+	text := strings.Builder{}
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) || !strings.ContainsRune(`%_\`, rune(pattern[i+1])) {
+				return "", "", false
+			}
+			i++
+			text.WriteByte(pattern[i])
+		case '%':
+			if i+1 != len(pattern) {
+				return "", "", false
+			}
+			suffix = true
+		case '_':
+			return "", "", false
+		default:
+			text.WriteByte(pattern[i])
+		}
+	}
+
+	switch {
+	case prefix && suffix && operator == Like:
+		return text.String(), Contains, true
+	case prefix && suffix && operator == ILike:
+		return text.String(), IContains, true
+	case suffix && operator == Like:
+		return text.String(), StartsWith, true
+	case prefix && operator == Like:
+		return text.String(), EndsWith, true
+	default:
+		return "", "", false
+	}
+}
+
+func (schema Schema) conditionNode(left stmt.Expression, operator Operator, right []stmt.Expression,
+	path string) (Node, error) {
+
+	name, err := schema.fieldName(left, path)
+	if err != nil {
+		return Node{}, err
+	}
+
+	args := make([]interface{}, len(right))
+	for i := range right {
+		value, ok := right[i].(stmt.Value)
+		if !ok {
+			return Node{}, newError(join(path, name), ErrInvalidNode, "unsupported operand %T", right[i])
+		}
+		args[i] = value.Value
+	}
+
+	if operator == In || operator == NotIn || operator == Between {
+		return schema.leafNode(name, operator, args, path)
+	}
+	return schema.leafNode(name, operator, args[0], path)
+}
+
+func (schema Schema) leafNode(name string, operator Operator, value interface{}, path string) (Node, error) {
+	path = join(path, name)
+
+	if !schema.Fields[name].allows(operator) {
+		return Node{}, newError(path, ErrOperatorNotAllowed, "%q", operator)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return Node{}, newError(path, ErrInvalidValue, "%s", err)
+	}
+
+	return Node{
+		Field:    name,
+		Operator: operator,
+		Value:    data,
+	}, nil
+}
+
+// fieldName returns the name of the field using given column.
+func (schema Schema) fieldName(expression stmt.Expression, path string) (string, error) {
+	identifier, ok := expression.(stmt.Identifier)
+	if !ok {
+		return "", newError(path, ErrInvalidNode, "unsupported operand %T", expression)
+	}
+	column, ok := identifier.Identifier.(string)
+	if !ok {
+		return "", newError(path, ErrInvalidNode, "unsupported operand %T", identifier.Identifier)
+	}
+
+	names := make([]string, 0, len(schema.Fields))
+	for name, field := range schema.Fields {
+		if columnName(name, field) == column {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", newError(join(path, column), ErrUnknownField, "")
+	}
+	sort.Strings(names)
+
+	return names[0], nil
+}
+
+func join(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
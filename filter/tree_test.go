@@ -0,0 +1,168 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3"
+	"github.com/ulule/loukoum/v3/filter"
+	"github.com/ulule/loukoum/v3/stmt"
+)
+
+func TestDecode(t *testing.T) {
+	is := require.New(t)
+
+	expression, err := schema.Decode([]byte(`{
+		"and": [
+			{"field": "status", "op": "in", "value": ["draft", "published"]},
+			{"or": [
+				{"field": "views", "op": "between", "value": [10, "20"]},
+				{"field": "published", "value": true},
+				{"not": {"field": "title", "op": "null", "value": true}}
+			]},
+			{"field": "created_at", "op": "lt", "value": "2024-01-01"},
+			{"field": "title", "op": "icontains", "value": "100%"}
+		]
+	}`))
+	is.NoError(err)
+
+	query, args := loukoum.Select("id").From("posts").Where(expression).Query()
+	is.Equal(`SELECT "id" FROM "posts" WHERE (((("status" IN ($1, $2)) AND `+
+		`((("views" BETWEEN $3 AND $4) OR ("is_published" = $5)) OR (NOT ("title" IS NULL)))) AND `+
+		`("created_at" < $6)) AND ("title" ILIKE $7))`, query)
+	is.Equal([]interface{}{
+		"draft", "published", int64(10), int64(20), true, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), `%100\%%`,
+	}, args)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	is := require.New(t)
+
+	scenarios := []struct {
+		data      string
+		parameter string
+		err       error
+	}{
+		{`[]`, "", filter.ErrInvalidNode},
+		{`{}`, "", filter.ErrInvalidNode},
+		{`{"and": []}`, "and", filter.ErrInvalidNode},
+		{`{"field": "views", "value": 1, "or": [{"field": "views", "value": 1}]}`, "", filter.ErrInvalidNode},
+		{`{"and": [{"field": "author", "value": "john"}]}`, "and[0].author", filter.ErrUnknownField},
+		{`{"or": [{"not": {"field": "status", "op": "ne", "value": "a"}}]}`, "or[0].not.status",
+			filter.ErrOperatorNotAllowed},
+		{`{"field": "views", "op": "gt", "value": true}`, "views", filter.ErrInvalidValue},
+		{`{"field": "views", "op": "gt", "value": 1.5}`, "views", filter.ErrInvalidValue},
+		{`{"field": "views", "op": "gt"}`, "views", filter.ErrInvalidValue},
+		{`{"field": "views", "op": "in", "value": 1}`, "views", filter.ErrInvalidValue},
+		{`{"field": "views", "op": "between", "value": [1]}`, "views", filter.ErrInvalidValue},
+		{`{"field": "title", "op": "null", "value": "yes"}`, "title", filter.ErrInvalidValue},
+		{`{"field": "published", "value": 1}`, "published", filter.ErrInvalidValue},
+	}
+
+	for _, scenario := range scenarios {
+		_, err := schema.Decode([]byte(scenario.data))
+		is.Error(err, scenario.data)
+		is.Equal(scenario.err, errors.Cause(err), scenario.data)
+
+		e, ok := err.(*filter.Error)
+		is.True(ok, scenario.data)
+		is.Equal(scenario.parameter, e.Parameter, scenario.data)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	is := require.New(t)
+
+	expression := loukoum.AndAll(
+		loukoum.Condition("status").In("draft", "published"),
+		loukoum.Or(
+			loukoum.Condition("views").NotBetween(10, 20),
+			loukoum.Condition("is_published").Equal(true),
+		),
+		loukoum.Not(loukoum.Condition("title").IsNull(true)),
+		loukoum.Condition("created_at").LessThan(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	data, err := schema.Encode(expression)
+	is.NoError(err)
+	is.JSONEq(`{
+		"and": [
+			{"field": "status", "op": "in", "value": ["draft", "published"]},
+			{"or": [
+				{"not": {"field": "views", "op": "between", "value": [10, 20]}},
+				{"field": "published", "op": "eq", "value": true}
+			]},
+			{"not": {"field": "title", "op": "null", "value": true}},
+			{"field": "created_at", "op": "lt", "value": "2024-01-01T00:00:00Z"}
+		]
+	}`, string(data))
+
+	decoded, err := schema.Decode(data)
+	is.NoError(err)
+
+	encoded, err := schema.Encode(decoded)
+	is.NoError(err)
+	is.JSONEq(string(data), string(encoded))
+}
+
+func TestEncode_Operators(t *testing.T) {
+	is := require.New(t)
+
+	scenarios := []string{
+		`{"field": "views", "op": "eq", "value": 10}`,
+		`{"field": "views", "op": "ne", "value": 10}`,
+		`{"field": "views", "op": "gt", "value": 10}`,
+		`{"field": "views", "op": "gte", "value": 10}`,
+		`{"field": "views", "op": "lt", "value": 10}`,
+		`{"field": "views", "op": "lte", "value": 10}`,
+		`{"field": "views", "op": "in", "value": [10, 20]}`,
+		`{"field": "views", "op": "nin", "value": [10, 20]}`,
+		`{"field": "views", "op": "between", "value": [10, 20]}`,
+		`{"field": "views", "op": "null", "value": false}`,
+		`{"field": "title", "op": "like", "value": "a_b%"}`,
+		`{"field": "title", "op": "ilike", "value": "%a%b"}`,
+		`{"field": "title", "op": "contains", "value": "100%_\\"}`,
+		`{"field": "title", "op": "icontains", "value": "100%"}`,
+		`{"field": "title", "op": "startswith", "value": "a_b"}`,
+		`{"field": "title", "op": "endswith", "value": "%"}`,
+	}
+
+	for _, scenario := range scenarios {
+		expression, err := schema.Decode([]byte(scenario))
+		is.NoError(err, scenario)
+
+		data, err := schema.Encode(expression)
+		is.NoError(err, scenario)
+		is.JSONEq(scenario, string(data))
+	}
+}
+
+func TestEncode_Errors(t *testing.T) {
+	is := require.New(t)
+
+	scenarios := []struct {
+		name      string
+		condition stmt.Expression
+		parameter string
+		err       error
+	}{
+		{"unknown column", loukoum.Condition("author").Equal("john"), "author", filter.ErrUnknownField},
+		{"operator not allowed", loukoum.Condition("status").NotEqual("a"), "status", filter.ErrOperatorNotAllowed},
+		{"unsupported operator", loukoum.Condition("views").IsDistinctFrom(1), "", filter.ErrInvalidNode},
+		{"unsupported operand", loukoum.Condition("views").Equal(loukoum.Column("id")), "views", filter.ErrInvalidNode},
+		{"unsupported expression", loukoum.Exists(loukoum.Select("id").From("posts")), "", filter.ErrInvalidNode},
+	}
+
+	for _, scenario := range scenarios {
+		_, err := schema.Encode(scenario.condition)
+		is.Error(err, scenario.name)
+		is.Equal(scenario.err, errors.Cause(err), scenario.name)
+
+		e, ok := err.(*filter.Error)
+		is.True(ok, scenario.name)
+		is.Equal(scenario.parameter, e.Parameter, scenario.name)
+	}
+}
@@ -0,0 +1,192 @@
+package format
+
+import (
+	"bufio"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CopyWriter encodes rows using the text or csv format of a COPY ... FROM STDIN statement.
+// Its output can be streamed to the server, for example with pgconn.PgConn.CopyFrom.
+//
+// Fields must be configured the same way as the options of the COPY statement.
+type CopyWriter struct {
+	CSV       bool
+	Delimiter rune
+	Null      string
+	Quote     rune
+
+	writer *bufio.Writer
+}
+
+// NewCopyTextWriter returns a CopyWriter using the default options of the text format.
+func NewCopyTextWriter(w io.Writer) *CopyWriter {
+	return &CopyWriter{
+		Delimiter: '\t',
+		Null:      `\N`,
+		writer:    bufio.NewWriter(w),
+	}
+}
+
+// NewCopyCSVWriter returns a CopyWriter using the default options of the csv format.
+func NewCopyCSVWriter(w io.Writer) *CopyWriter {
+	return &CopyWriter{
+		CSV:       true,
+		Delimiter: ',',
+		Quote:     '"',
+		writer:    bufio.NewWriter(w),
+	}
+}
+
+// Write encodes given row.
+func (w *CopyWriter) Write(row []interface{}) error {
+	for i := range row {
+		if i != 0 {
+			_, err := w.writer.WriteRune(w.Delimiter)
+			if err != nil {
+				return err
+			}
+		}
+
+		value, null, err := CopyValue(row[i])
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case null:
+			_, err = w.writer.WriteString(w.Null)
+		case w.CSV:
+			_, err = w.writer.WriteString(w.quote(value))
+		default:
+			_, err = w.writer.WriteString(w.escape(value))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.writer.WriteRune('\n')
+	return err
+}
+
+// WriteAll encodes given rows and flushes the underlying writer.
+func (w *CopyWriter) WriteAll(rows [][]interface{}) error {
+	for i := range rows {
+		err := w.Write(rows[i])
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *CopyWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+func (w *CopyWriter) escape(value string) string {
+	buffer := strings.Builder{}
+	for _, char := range value {
+		switch char {
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		case w.Delimiter:
+			buffer.WriteRune('\\')
+			buffer.WriteRune(char)
+		default:
+			buffer.WriteRune(char)
+		}
+	}
+	return buffer.String()
+}
+
+func (w *CopyWriter) quote(value string) string {
+	quote := string(w.Quote)
+	if value != w.Null && !strings.ContainsAny(value, string(w.Delimiter)+quote+"\r\n") &&
+		value != `\.` {
+		return value
+	}
+	return quote + strings.ReplaceAll(value, quote, quote+quote) + quote
+}
+
+// CopyValue returns the text representation of given value, as expected by a COPY statement.
+// The boolean is true if the value is null.
+func CopyValue(arg interface{}) (string, bool, error) { // nolint: gocyclo
+	if arg == nil {
+		return "", true, nil
+	}
+
+	switch value := arg.(type) {
+	case string:
+		return value, false, nil
+	case []byte:
+		return `\x` + hex.EncodeToString(value), false, nil
+	case time.Time:
+		return value.Format("2006-01-02 15:04:05.999999-07:00"), false, nil
+	case []string:
+		elements := make([]string, len(value))
+		for i := range value {
+			elements[i] = `"` + arrayEscaper.Replace(value[i]) + `"`
+		}
+		return "{" + strings.Join(elements, ",") + "}", false, nil
+	case []int64:
+		elements := make([]string, len(value))
+		for i := range value {
+			elements[i] = Int(value[i])
+		}
+		return "{" + strings.Join(elements, ",") + "}", false, nil
+	case driver.Valuer:
+		reflectvalue := reflect.ValueOf(value)
+		if reflectvalue.Kind() == reflect.Ptr && reflectvalue.IsNil() {
+			return "", true, nil
+		}
+
+		v, err := value.Value()
+		if err != nil {
+			return "", false, err
+		}
+		return CopyValue(v)
+	case int:
+		return Int(int64(value)), false, nil
+	case int8:
+		return Int(int64(value)), false, nil
+	case int16:
+		return Int(int64(value)), false, nil
+	case int32:
+		return Int(int64(value)), false, nil
+	case int64:
+		return Int(value), false, nil
+	case uint:
+		return Uint(uint64(value)), false, nil
+	case uint8:
+		return Uint(uint64(value)), false, nil
+	case uint16:
+		return Uint(uint64(value)), false, nil
+	case uint32:
+		return Uint(uint64(value)), false, nil
+	case uint64:
+		return Uint(value), false, nil
+	case bool:
+		return strconv.FormatBool(value), false, nil
+	case float32:
+		return Float(float64(value)), false, nil
+	case float64:
+		return Float(value), false, nil
+	default:
+		return "", false, fmt.Errorf("loukoum: cannot use %T as copy value", arg)
+	}
+}
@@ -0,0 +1,57 @@
+package format_test
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ulule/loukoum/v3/format"
+)
+
+func TestCopyWriter(t *testing.T) {
+	is := require.New(t)
+
+	date := time.Date(2024, 1, 2, 3, 4, 5, 600000000, time.UTC)
+	rows := [][]interface{}{
+		{int64(1), "tab\there", nil, true, date},
+		{2, "back\\slash\nline", sql.NullString{}, false, []byte{0xde, 0xad}},
+		{3.5, "", sql.NullString{Valid: true, String: "a,\"b\""}, []string{"x", "y z"}, []int64{1, 2}},
+	}
+
+	{
+		buffer := &bytes.Buffer{}
+		writer := format.NewCopyTextWriter(buffer)
+		is.NoError(writer.WriteAll(rows))
+		is.Equal(
+			"1\ttab\\there\t\\N\ttrue\t2024-01-02 03:04:05.6+00:00\n"+
+				"2\tback\\\\slash\\nline\t\\N\tfalse\t\\\\xdead\n"+
+				"3.5\t\ta,\"b\"\t{\"x\",\"y z\"}\t{1,2}\n",
+			buffer.String(),
+		)
+	}
+	{
+		buffer := &bytes.Buffer{}
+		writer := format.NewCopyCSVWriter(buffer)
+		is.NoError(writer.WriteAll(rows))
+		is.Equal(
+			"1,tab\there,,true,2024-01-02 03:04:05.6+00:00\n"+
+				"2,\"back\\slash\nline\",,false,\\xdead\n"+
+				"3.5,\"\",\"a,\"\"b\"\"\",\"{\"\"x\"\",\"\"y z\"\"}\",\"{1,2}\"\n",
+			buffer.String(),
+		)
+	}
+	{
+		buffer := &bytes.Buffer{}
+		writer := format.NewCopyTextWriter(buffer)
+		writer.Delimiter = '|'
+		is.NoError(writer.WriteAll([][]interface{}{{"a|b", "c"}}))
+		is.Equal("a\\|b|c\n", buffer.String())
+	}
+	{
+		writer := format.NewCopyTextWriter(&bytes.Buffer{})
+		is.Error(writer.Write([]interface{}{struct{}{}}))
+	}
+}
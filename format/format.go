@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // Value formats the given value.
@@ -23,6 +27,14 @@ func Value(arg interface{}) string { // nolint: gocyclo
 		return Bytes(value)
 	case time.Time:
 		return Time(value)
+	case []string:
+		return StringArray(value)
+	case []int64:
+		return IntArray(value)
+	case map[string]string:
+		return Hstore(value)
+	case pgtype.RangeValuer:
+		return Range(value)
 	case driver.Valuer:
 		reflectvalue := reflect.ValueOf(value)
 		if reflectvalue.Kind() == reflect.Ptr &&
@@ -96,6 +108,97 @@ func Bytes(value []byte) string {
 	return fmt.Sprintf("decode('%s', 'hex')", encoded)
 }
 
+var arrayEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// StringArray formats the given strings as an array literal.
+func StringArray(values []string) string {
+	elements := make([]string, len(values))
+	for i := range values {
+		elements[i] = `"` + arrayEscaper.Replace(values[i]) + `"`
+	}
+	return String("{" + strings.Join(elements, ",") + "}")
+}
+
+// IntArray formats the given numbers as an array literal.
+func IntArray(values []int64) string {
+	elements := make([]string, len(values))
+	for i := range values {
+		elements[i] = Int(values[i])
+	}
+	return String("{" + strings.Join(elements, ",") + "}")
+}
+
+// Hstore formats the given map as a hstore literal.
+func Hstore(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = `"` + arrayEscaper.Replace(key) + `"=>"` + arrayEscaper.Replace(values[key]) + `"`
+	}
+	return String(strings.Join(pairs, ", "))
+}
+
+// Range formats the given range as a range literal.
+func Range(value pgtype.RangeValuer) string {
+	if value.IsNull() {
+		return "NULL"
+	}
+
+	lowerType, upperType := value.BoundTypes()
+	if lowerType == pgtype.Empty || upperType == pgtype.Empty {
+		return String("empty")
+	}
+
+	lower, upper := value.Bounds()
+	buffer := &bytes.Buffer{}
+
+	if lowerType == pgtype.Inclusive {
+		writeRune(buffer, '[')
+	} else {
+		writeRune(buffer, '(')
+	}
+	if lowerType != pgtype.Unbounded {
+		writeString(buffer, rangeBound(lower))
+	}
+	writeRune(buffer, ',')
+	if upperType != pgtype.Unbounded {
+		writeString(buffer, rangeBound(upper))
+	}
+	if upperType == pgtype.Inclusive {
+		writeRune(buffer, ']')
+	} else {
+		writeRune(buffer, ')')
+	}
+
+	return String(buffer.String())
+}
+
+func rangeBound(value interface{}) string {
+	if reflectvalue := reflect.ValueOf(value); reflectvalue.Kind() == reflect.Ptr {
+		if reflectvalue.IsNil() {
+			return ""
+		}
+		value = reflectvalue.Elem().Interface()
+	}
+
+	text, null, err := CopyValue(value)
+	if err != nil {
+		panic(fmt.Sprintf("loukoum: cannot use %T as range bound", value))
+	}
+	if null {
+		return ""
+	}
+	if text == "" || strings.ContainsAny(text, `()[],"\ `) {
+		return `"` + arrayEscaper.Replace(text) + `"`
+	}
+	return text
+}
+
 // Int formats the given number.
 func Int(value int64) string {
 	return strconv.FormatInt(value, 10)
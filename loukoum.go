@@ -1,6 +1,8 @@
 package loukoum
 
 import (
+	"fmt"
+
 	"github.com/ulule/loukoum/v3/builder"
 	"github.com/ulule/loukoum/v3/stmt"
 	"github.com/ulule/loukoum/v3/types"
@@ -17,15 +19,76 @@ const (
 	LeftOuterJoin = types.LeftOuterJoin
 	// RightOuterJoin is used for "RIGHT OUTER JOIN" in join statement.
 	RightOuterJoin = types.RightOuterJoin
+	// FullJoin is used for "FULL JOIN" in join statement.
+	FullJoin = types.FullJoin
+	// FullOuterJoin is used for "FULL OUTER JOIN" in join statement.
+	FullOuterJoin = types.FullOuterJoin
+	// CrossJoin is used for "CROSS JOIN" in join statement.
+	CrossJoin = types.CrossJoin
+	// NaturalJoin is used for "NATURAL JOIN" in join statement.
+	NaturalJoin = types.NaturalJoin
+	// NaturalLeftJoin is used for "NATURAL LEFT JOIN" in join statement.
+	NaturalLeftJoin = types.NaturalLeftJoin
+	// NaturalRightJoin is used for "NATURAL RIGHT JOIN" in join statement.
+	NaturalRightJoin = types.NaturalRightJoin
+	// NaturalFullJoin is used for "NATURAL FULL JOIN" in join statement.
+	NaturalFullJoin = types.NaturalFullJoin
 	// Asc is used for "ORDER BY" statement.
 	Asc = types.Asc
 	// Desc is used for "ORDER BY" statement.
 	Desc = types.Desc
+	// CopyText is used for "FORMAT text" in copy statement.
+	CopyText = types.CopyText
+	// CopyCSV is used for "FORMAT csv" in copy statement.
+	CopyCSV = types.CopyCSV
+	// CopyBinary is used for "FORMAT binary" in copy statement.
+	CopyBinary = types.CopyBinary
+	// ExplainText is used for "FORMAT TEXT" in explain statement.
+	ExplainText = types.ExplainText
+	// ExplainJSON is used for "FORMAT JSON" in explain statement.
+	ExplainJSON = types.ExplainJSON
+	// ExplainXML is used for "FORMAT XML" in explain statement.
+	ExplainXML = types.ExplainXML
+	// ExplainYAML is used for "FORMAT YAML" in explain statement.
+	ExplainYAML = types.ExplainYAML
+	// ReadUncommitted is used for "ISOLATION LEVEL READ UNCOMMITTED" in begin statement.
+	ReadUncommitted = types.ReadUncommitted
+	// ReadCommitted is used for "ISOLATION LEVEL READ COMMITTED" in begin statement.
+	ReadCommitted = types.ReadCommitted
+	// RepeatableRead is used for "ISOLATION LEVEL REPEATABLE READ" in begin statement.
+	RepeatableRead = types.RepeatableRead
+	// Serializable is used for "ISOLATION LEVEL SERIALIZABLE" in begin statement.
+	Serializable = types.Serializable
+	// AccessShareLock is used for "IN ACCESS SHARE MODE" in lock statement.
+	AccessShareLock = types.AccessShareLock
+	// RowShareLock is used for "IN ROW SHARE MODE" in lock statement.
+	RowShareLock = types.RowShareLock
+	// RowExclusiveLock is used for "IN ROW EXCLUSIVE MODE" in lock statement.
+	RowExclusiveLock = types.RowExclusiveLock
+	// ShareUpdateExclusiveLock is used for "IN SHARE UPDATE EXCLUSIVE MODE" in lock statement.
+	ShareUpdateExclusiveLock = types.ShareUpdateExclusiveLock
+	// ShareLock is used for "IN SHARE MODE" in lock statement.
+	ShareLock = types.ShareLock
+	// ShareRowExclusiveLock is used for "IN SHARE ROW EXCLUSIVE MODE" in lock statement.
+	ShareRowExclusiveLock = types.ShareRowExclusiveLock
+	// ExclusiveLock is used for "IN EXCLUSIVE MODE" in lock statement.
+	ExclusiveLock = types.ExclusiveLock
+	// AccessExclusiveLock is used for "IN ACCESS EXCLUSIVE MODE" in lock statement.
+	AccessExclusiveLock = types.AccessExclusiveLock
+	// ColonPrefix is used for ":name" placeholders in named queries.
+	ColonPrefix = types.ColonPrefix
+	// AtPrefix is used for "@name" placeholders in named queries.
+	AtPrefix = types.AtPrefix
+	// DollarPrefix is used for "$name" placeholders in named queries.
+	DollarPrefix = types.DollarPrefix
 )
 
 // Map is a key/value map.
 type Map = types.Map
 
+// ExplainOptions are the options of an EXPLAIN statement.
+type ExplainOptions = stmt.ExplainOptions
+
 // Pair takes a key and its related value and returns a Pair.
 func Pair(key, value interface{}) types.Pair {
 	return types.Pair{Key: key, Value: value}
@@ -36,6 +99,12 @@ func Value(value interface{}) stmt.Value {
 	return stmt.NewValue(value)
 }
 
+// Named is a wrapper to create a value bound to an explicit parameter name, which is reused
+// wherever it appears.
+func Named(name string, value interface{}) stmt.NamedValue {
+	return stmt.NewNamedValue(name, value)
+}
+
 // Select starts a SelectBuilder using the given columns.
 func Select(columns ...interface{}) builder.Select {
 	return builder.NewSelect().Columns(columns...)
@@ -51,6 +120,29 @@ func Table(name string) stmt.Table {
 	return stmt.NewTable(name)
 }
 
+// DerivedTable is a wrapper to use a subquery, a VALUES list or a set-returning function as a table
+// with given alias and optional column aliases.
+func DerivedTable(value interface{}, alias string, columns ...string) stmt.DerivedTable {
+	return stmt.NewDerivedTable(stmt.NewExpression(value), alias, columns...)
+}
+
+// Values is a wrapper to create a VALUES list from given rows, to be used as a derived table.
+func Values(rows ...[]interface{}) stmt.Values {
+	if len(rows) == 0 {
+		panic("loukoum: values list requires at least one row")
+	}
+	return stmt.NewValues(stmt.NewArrayListExpression(rows))
+}
+
+// Using is a wrapper to create a USING clause for a join statement.
+func Using(columns ...string) stmt.JoinUsing {
+	list := make([]stmt.Column, 0, len(columns))
+	for i := range columns {
+		list = append(list, stmt.NewColumn(columns[i]))
+	}
+	return stmt.NewJoinUsing(list)
+}
+
 // On is a wrapper to create a new On statement.
 func On(left string, right string) stmt.OnClause {
 	return stmt.NewOnClause(stmt.NewColumn(left), stmt.NewColumn(right))
@@ -67,10 +159,34 @@ func OrOn(left stmt.OnExpression, right stmt.OnExpression) stmt.OnExpression {
 }
 
 // Condition is a wrapper to create a new Identifier statement.
+// Any expression, such as a column, a value, a call, a row or a subquery, can be given to compare it to another one.
 func Condition(column interface{}) stmt.Identifier {
 	return stmt.NewIdentifier(column)
 }
 
+// Row is a wrapper to create a new Row expression, such as "(a, b)", which can be compared to another
+// row or to a list of rows. Strings are handled as column names: use Value to bind a string.
+func Row(values ...interface{}) stmt.Row {
+	return stmt.NewRow(toOperands(values)...)
+}
+
+// Any is a wrapper to create a new ANY expression, to compare a value with the elements of an array
+// or the rows of a subquery. A slice is bound as a single array parameter.
+func Any(value interface{}) stmt.Quantifier {
+	return stmt.NewAny(stmt.NewArrayParameter(value))
+}
+
+// All is a wrapper to create a new ALL expression, to compare a value with the elements of an array
+// or the rows of a subquery. A slice is bound as a single array parameter.
+func All(value interface{}) stmt.Quantifier {
+	return stmt.NewAll(stmt.NewArrayParameter(value))
+}
+
+// EscapeLike escapes the wildcards of given string so it's matched literally in a LIKE pattern.
+func EscapeLike(value string) string {
+	return stmt.EscapeLike(value)
+}
+
 // Order is a wrapper to create a new Order statement.
 func Order(column string, option ...types.OrderType) stmt.Order {
 	order := types.Asc
@@ -100,11 +216,28 @@ func Or(left stmt.Expression, right stmt.Expression) stmt.InfixExpression {
 	return stmt.NewInfixExpression(left, stmt.NewLogicalOperator(types.Or), right)
 }
 
+// AndAll is a wrapper to join given conditions using an AND operator.
+// Nil and empty conditions are skipped, so a filter can be built conditionally.
+func AndAll(conditions ...stmt.Expression) stmt.Expression {
+	return stmt.NewLogicalExpression(types.And, conditions...)
+}
+
+// OrAny is a wrapper to join given conditions using an OR operator.
+// Nil and empty conditions are skipped, so a filter can be built conditionally.
+func OrAny(conditions ...stmt.Expression) stmt.Expression {
+	return stmt.NewLogicalExpression(types.Or, conditions...)
+}
+
 // Raw is a wrapper to create a new Raw expression.
 func Raw(value string) stmt.Raw {
 	return stmt.NewRaw(value)
 }
 
+// Subquery is a wrapper to create a new scalar Subquery expression, which can be compared to another expression.
+func Subquery(value interface{}) stmt.Subquery {
+	return stmt.NewSubquery(value)
+}
+
 // Exists is a wrapper to create a new Exists expression.
 func Exists(value interface{}) stmt.Exists {
 	return stmt.NewExists(value)
@@ -135,11 +268,240 @@ func Sum(value string) stmt.Sum {
 	return stmt.NewSum(value)
 }
 
+// JSON is a wrapper to create a new JSONValue expression.
+func JSON(value interface{}) stmt.JSONValue {
+	return stmt.NewJSONValue(value)
+}
+
+// JSONBSet is a wrapper to create a new jsonb_set call on given column.
+func JSONBSet(column interface{}, path []string, value interface{}) stmt.Call {
+	return stmt.NewJSONBSet(stmt.NewIdentifier(column), path, value, true)
+}
+
+// JSONBBuildObject is a wrapper to create a new jsonb_build_object call.
+func JSONBBuildObject(pairs ...types.Pair) stmt.Call {
+	return stmt.NewJSONBBuildObject(pairs...)
+}
+
+// ToTSVector is a wrapper to create a new TSVector expression.
+// Config can be empty to use the default text search configuration.
+func ToTSVector(config string, document interface{}) stmt.TSVector {
+	return stmt.NewTSVector(config, document)
+}
+
+// ToTSQuery is a wrapper to create a new to_tsquery expression.
+func ToTSQuery(config string, query string) stmt.TSQuery {
+	return stmt.NewTSQuery(stmt.ToTSQuery, config, query)
+}
+
+// PlainToTSQuery is a wrapper to create a new plainto_tsquery expression.
+func PlainToTSQuery(config string, query string) stmt.TSQuery {
+	return stmt.NewTSQuery(stmt.PlainToTSQuery, config, query)
+}
+
+// PhraseToTSQuery is a wrapper to create a new phraseto_tsquery expression.
+func PhraseToTSQuery(config string, query string) stmt.TSQuery {
+	return stmt.NewTSQuery(stmt.PhraseToTSQuery, config, query)
+}
+
+// WebSearchToTSQuery is a wrapper to create a new websearch_to_tsquery expression.
+func WebSearchToTSQuery(config string, query string) stmt.TSQuery {
+	return stmt.NewTSQuery(stmt.WebSearchToTSQuery, config, query)
+}
+
+// TSRank is a wrapper to create a new TSRank expression.
+func TSRank(vector interface{}, query interface{}) stmt.TSRank {
+	return stmt.NewTSRank(vector, query)
+}
+
+// TSHeadline is a wrapper to create a new TSHeadline expression.
+func TSHeadline(config string, document interface{}, query interface{}) stmt.TSHeadline {
+	return stmt.NewTSHeadline(config, document, query)
+}
+
+// Func is a wrapper to create a new function Call expression.
+// Name can be qualified with a schema, and arguments that aren't an expression are bound as values.
+func Func(name string, args ...interface{}) stmt.Call {
+	return stmt.NewCall(name, toExpressions(args)...)
+}
+
+// As is a wrapper to give an alias name to an expression.
+func As(value interface{}, alias string) stmt.Alias {
+	return stmt.NewAlias(stmt.NewExpression(value), alias)
+}
+
+// Add is a wrapper to create a new Arithmetic expression using an addition.
+// A string is used as a column name.
+func Add(left interface{}, right interface{}) stmt.Arithmetic {
+	return stmt.NewArithmetic(toOperand(left), types.Plus, toOperand(right))
+}
+
+// Sub is a wrapper to create a new Arithmetic expression using a subtraction.
+// A string is used as a column name.
+func Sub(left interface{}, right interface{}) stmt.Arithmetic {
+	return stmt.NewArithmetic(toOperand(left), types.Minus, toOperand(right))
+}
+
+// Mul is a wrapper to create a new Arithmetic expression using a multiplication.
+// A string is used as a column name.
+func Mul(left interface{}, right interface{}) stmt.Arithmetic {
+	return stmt.NewArithmetic(toOperand(left), types.Multiply, toOperand(right))
+}
+
+// Div is a wrapper to create a new Arithmetic expression using a division.
+// A string is used as a column name.
+func Div(left interface{}, right interface{}) stmt.Arithmetic {
+	return stmt.NewArithmetic(toOperand(left), types.Divide, toOperand(right))
+}
+
+// Mod is a wrapper to create a new Arithmetic expression using a modulo.
+// A string is used as a column name.
+func Mod(left interface{}, right interface{}) stmt.Arithmetic {
+	return stmt.NewArithmetic(toOperand(left), types.Modulo, toOperand(right))
+}
+
+// Concat is a wrapper to create a new Arithmetic expression using a string concatenation.
+// A string is used as a column name.
+func Concat(left interface{}, right interface{}, others ...interface{}) stmt.Arithmetic {
+	concat := stmt.NewArithmetic(toOperand(left), types.Concat, toOperand(right))
+	for i := range others {
+		concat = stmt.NewArithmetic(concat, types.Concat, toOperand(others[i]))
+	}
+	return concat
+}
+
+// Array is a wrapper to create a new array constructor, such as "ARRAY[a, b]".
+// With a single select query, the array is built from its rows: "ARRAY(SELECT ...)".
+// An empty array must be given a type with Cast, such as "CAST(ARRAY[] AS text[])".
+func Array(values ...interface{}) stmt.ArrayConstructor {
+	return stmt.NewArrayConstructor(toExpressions(values)...)
+}
+
+// ArrayLength is a wrapper to create a new array_length call, for the given dimension.
+func ArrayLength(column interface{}, dimension int) stmt.Call {
+	return stmt.NewCall("array_length", stmt.NewIdentifier(column), stmt.SubscriptIndex(dimension))
+}
+
+// Cardinality is a wrapper to create a new cardinality call, which returns the total number of
+// elements of an array.
+func Cardinality(column interface{}) stmt.Call {
+	return stmt.NewCall("cardinality", stmt.NewIdentifier(column))
+}
+
+// Range is a wrapper to create a new range constructor call, such as "tstzrange($1, $2, '[)')".
+// Bounds are either "[)", "[]", "(]" or "()": when omitted, PostgreSQL uses "[)".
+func Range(kind string, lower, upper interface{}, bounds ...string) stmt.Call {
+	args := []stmt.Expression{stmt.NewExpression(lower), stmt.NewExpression(upper)}
+	if len(bounds) > 1 {
+		panic("loukoum: range constructor accepts a single bounds argument")
+	}
+	if len(bounds) == 1 {
+		switch bounds[0] {
+		case "[)", "[]", "(]", "()":
+			args = append(args, stmt.NewRaw("'"+bounds[0]+"'"))
+		default:
+			panic(fmt.Sprintf("loukoum: invalid range bounds %s", bounds[0]))
+		}
+	}
+	return stmt.NewCall(kind, args...)
+}
+
+// Lower is a wrapper to create a new lower call, which returns the lower bound of a range.
+func Lower(column interface{}) stmt.Call {
+	return stmt.NewCall("lower", stmt.NewIdentifier(column))
+}
+
+// Upper is a wrapper to create a new upper call, which returns the upper bound of a range.
+func Upper(column interface{}) stmt.Call {
+	return stmt.NewCall("upper", stmt.NewIdentifier(column))
+}
+
+// Negate is a wrapper to create a new Unary expression using a minus sign.
+// A string is used as a column name.
+func Negate(value interface{}) stmt.Unary {
+	return stmt.NewNegate(toOperand(value))
+}
+
+// Not is a wrapper to create a new Unary expression using a NOT operator.
+// A string is used as a boolean column name.
+func Not(value interface{}) stmt.Unary {
+	column, ok := value.(string)
+	if ok {
+		return stmt.NewNot(stmt.NewIdentifier(column))
+	}
+	return stmt.NewNot(stmt.NewExpression(value))
+}
+
+// Case is a wrapper to create a new Case expression.
+func Case() stmt.Case {
+	return stmt.NewCase()
+}
+
+// Cast is a wrapper to create a new Cast expression.
+// A string is used as a column name.
+func Cast(value interface{}, kind string) stmt.Cast {
+	return stmt.NewCast(toOperand(value), kind)
+}
+
+// Coalesce is a wrapper to create a new COALESCE call.
+// A string is used as a column name.
+func Coalesce(values ...interface{}) stmt.Call {
+	return stmt.NewCoalesce(toOperands(values)...)
+}
+
+// NullIf is a wrapper to create a new NULLIF call.
+// A string is used as a column name.
+func NullIf(value interface{}, other interface{}) stmt.Call {
+	return stmt.NewNullIf(toOperand(value), toOperand(other))
+}
+
+// Greatest is a wrapper to create a new GREATEST call.
+// A string is used as a column name.
+func Greatest(values ...interface{}) stmt.Call {
+	return stmt.NewGreatest(toOperands(values)...)
+}
+
+// Least is a wrapper to create a new LEAST call.
+// A string is used as a column name.
+func Least(values ...interface{}) stmt.Call {
+	return stmt.NewLeast(toOperands(values)...)
+}
+
 // With is a wrapper to create a new WithQuery statement.
 func With(name string, value interface{}) stmt.WithQuery {
 	return stmt.NewWithQuery(name, value)
 }
 
+// WithRecursive is a wrapper to create a new recursive WithQuery statement, with given column names.
+func WithRecursive(name string, value interface{}, columns ...string) stmt.WithQuery {
+	return stmt.NewWithQuery(name, value).WithColumns(columns...).Recursive()
+}
+
+// Union is a wrapper to combine given queries with a UNION operator.
+func Union(queries ...interface{}) stmt.Compound {
+	return stmt.NewCompound(types.Union, toExpressions(queries)...)
+}
+
+// UnionAll is a wrapper to combine given queries with a UNION ALL operator.
+func UnionAll(queries ...interface{}) stmt.Compound {
+	return stmt.NewCompound(types.UnionAll, toExpressions(queries)...)
+}
+
+// Intersect is a wrapper to combine given queries with an INTERSECT operator.
+func Intersect(queries ...interface{}) stmt.Compound {
+	return stmt.NewCompound(types.Intersect, toExpressions(queries)...)
+}
+
+// Except is a wrapper to combine given queries with an EXCEPT operator.
+func Except(queries ...interface{}) stmt.Compound {
+	return stmt.NewCompound(types.Except, toExpressions(queries)...)
+}
+
+// Default is a wrapper to create a new Default expression, assigning its default value to a column.
+func Default() stmt.Default {
+	return stmt.NewDefault()
+}
+
 // Insert starts an InsertBuilder using the given table as into clause.
 func Insert(into interface{}) builder.Insert {
 	return builder.NewInsert().Into(into)
@@ -150,6 +512,147 @@ func Delete(from interface{}) builder.Delete {
 	return builder.NewDelete().From(from)
 }
 
+// Merge starts a Merge builder using the given table as target.
+func Merge(into interface{}) builder.Merge {
+	return builder.NewMerge().Into(into)
+}
+
+// MergeUpdate is a wrapper to create a new MergeUpdateAction statement using given pairs.
+func MergeUpdate(args ...interface{}) stmt.MergeUpdateAction {
+	return stmt.NewMergeUpdateAction(builder.ToSet(args))
+}
+
+// MergeDelete is a wrapper to create a new MergeDeleteAction statement.
+func MergeDelete() stmt.MergeDeleteAction {
+	return stmt.NewMergeDeleteAction()
+}
+
+// MergeInsert is a wrapper to create a new MergeInsertAction statement using given pairs.
+// Without pairs, the row is inserted using default values.
+func MergeInsert(args ...interface{}) stmt.MergeInsertAction {
+	if len(args) == 0 {
+		return stmt.NewMergeInsertAction(nil, stmt.Values{})
+	}
+	columns, expressions := builder.ToSet(args).Pairs.Values()
+	return stmt.NewMergeInsertAction(columns, stmt.NewValues(stmt.NewArrayListExpression(expressions)))
+}
+
+// MergeDoNothing is a wrapper to create a new MergeNoAction statement.
+func MergeDoNothing() stmt.MergeNoAction {
+	return stmt.NewMergeNoAction()
+}
+
+// CopyFrom starts a Copy builder loading rows in given table from standard input.
+func CopyFrom(table interface{}, columns ...interface{}) builder.Copy {
+	return builder.NewCopyFrom(table, columns...)
+}
+
+// CopyTo starts a Copy builder writing rows of given table or select query on standard output.
+func CopyTo(source interface{}, columns ...interface{}) builder.Copy {
+	return builder.NewCopyTo(source, columns...)
+}
+
+// Explain starts an Explain builder of the query generated by given builder.
+func Explain(query builder.Builder, options ExplainOptions) builder.Explain {
+	return builder.NewExplain(query, options)
+}
+
+// Begin starts a Begin builder, which starts a transaction.
+func Begin() builder.Begin {
+	return builder.NewBegin()
+}
+
+// Commit is a wrapper to create a COMMIT statement.
+func Commit() builder.Command {
+	return builder.NewCommand(stmt.NewCommit())
+}
+
+// Rollback is a wrapper to create a ROLLBACK statement.
+func Rollback() builder.Command {
+	return builder.NewCommand(stmt.NewRollback())
+}
+
+// RollbackTo is a wrapper to create a ROLLBACK TO SAVEPOINT statement.
+func RollbackTo(savepoint string) builder.Command {
+	if savepoint == "" {
+		panic("loukoum: given savepoint is undefined")
+	}
+	return builder.NewCommand(stmt.NewRollbackTo(savepoint))
+}
+
+// Savepoint is a wrapper to create a SAVEPOINT statement.
+func Savepoint(name string) builder.Command {
+	return builder.NewCommand(stmt.NewSavepoint(name))
+}
+
+// Release is a wrapper to create a RELEASE SAVEPOINT statement.
+func Release(savepoint string) builder.Command {
+	return builder.NewCommand(stmt.NewRelease(savepoint))
+}
+
+// SetParameter is a wrapper to create a SET statement, which changes a run-time parameter for the
+// session. The value is embedded in the query as an escaped literal.
+func SetParameter(name string, value interface{}) builder.Command {
+	return builder.NewCommand(stmt.NewSetParameter(name, stmt.NewExpression(value), false))
+}
+
+// SetLocal is a wrapper to create a SET LOCAL statement, which changes a run-time parameter for the
+// current transaction. The value is embedded in the query as an escaped literal.
+func SetLocal(name string, value interface{}) builder.Command {
+	return builder.NewCommand(stmt.NewSetParameter(name, stmt.NewExpression(value), true))
+}
+
+// Lock starts a Lock builder using the given tables.
+func Lock(tables ...interface{}) builder.Lock {
+	return builder.NewLock(tables...)
+}
+
+// Truncate starts a Truncate builder using the given tables.
+func Truncate(tables ...interface{}) builder.Truncate {
+	return builder.NewTruncate(tables...)
+}
+
+// Listen is a wrapper to create a LISTEN statement.
+func Listen(channel string) builder.Command {
+	return builder.NewCommand(stmt.NewListen(channel))
+}
+
+// Unlisten is a wrapper to create an UNLISTEN statement. Without channel, every registration is
+// removed.
+func Unlisten(channel ...string) builder.Command {
+	if len(channel) > 1 {
+		panic("loukoum: unlisten statement accepts a single channel")
+	}
+	if len(channel) == 0 {
+		return builder.NewCommand(stmt.NewUnlisten(""))
+	}
+	if channel[0] == "" {
+		panic("loukoum: given channel is undefined")
+	}
+	return builder.NewCommand(stmt.NewUnlisten(channel[0]))
+}
+
+// Notify is a wrapper to create a NOTIFY statement, with an optional payload embedded in the query
+// as an escaped literal. Use PgNotify to bind the payload instead.
+func Notify(channel string, payload ...string) builder.Command {
+	if len(payload) > 1 {
+		panic("loukoum: notify statement accepts a single payload")
+	}
+	if len(payload) == 0 {
+		return builder.NewCommand(stmt.NewNotify(channel, ""))
+	}
+	return builder.NewCommand(stmt.NewNotify(channel, payload[0]))
+}
+
+// PgNotify starts a Select builder calling pg_notify() with given channel and payload, which are
+// both bound as parameters.
+func PgNotify(channel, payload string) builder.Select {
+	if channel == "" {
+		panic("loukoum: given channel is undefined")
+	}
+	return Select(Func("pg_notify", channel, payload))
+}
+
 // Update starts an Update builder using the given table.
 func Update(table interface{}) builder.Update {
 	return builder.NewUpdate(table)
@@ -164,3 +667,54 @@ func DoNothing() stmt.ConflictNoAction {
 func DoUpdate(args ...interface{}) stmt.ConflictUpdateAction {
 	return stmt.NewConflictUpdateAction(builder.ToSet(args))
 }
+
+// DoUpdateExcluded is a wrapper to create a new ConflictUpdateAction statement, which assigns every
+// inserted column, except given ones, to its value proposed for insertion.
+func DoUpdateExcluded(except ...string) stmt.ConflictUpdateAction {
+	columns := make([]stmt.Column, 0, len(except))
+	for i := range except {
+		columns = append(columns, stmt.NewColumn(except[i]))
+	}
+	return stmt.NewConflictUpdateExcludedAction(columns)
+}
+
+// Excluded is a wrapper to reference the value proposed for insertion in a ON CONFLICT DO UPDATE clause.
+func Excluded(column string) stmt.Column {
+	return stmt.NewExcludedColumn(column)
+}
+
+// ConflictTarget is a wrapper to create a new ConflictTarget statement using given columns and expressions.
+// Use its Where method to define a partial index predicate.
+func ConflictTarget(columns ...interface{}) stmt.ConflictTarget {
+	return builder.ToConflictTarget(columns)
+}
+
+// OnConstraint is a wrapper to create a new ConflictTarget statement using given constraint name.
+func OnConstraint(name string) stmt.ConflictTarget {
+	return stmt.NewConflictConstraint(name)
+}
+
+// toOperand returns the expression of given operand, using a string as a column name.
+func toOperand(value interface{}) stmt.Expression {
+	column, ok := value.(string)
+	if ok {
+		return builder.ToColumn(column)
+	}
+	return stmt.NewExpression(value)
+}
+
+func toOperands(values []interface{}) []stmt.Expression {
+	expressions := make([]stmt.Expression, len(values))
+	for i := range values {
+		expressions[i] = toOperand(values[i])
+	}
+	return expressions
+}
+
+func toExpressions(values []interface{}) []stmt.Expression {
+	expressions := make([]stmt.Expression, len(values))
+	for i := range values {
+		expressions[i] = stmt.NewExpression(values[i])
+	}
+	return expressions
+}
@@ -0,0 +1,53 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Alias gives a name to an expression used in a SELECT or a RETURNING clause.
+type Alias struct {
+	Value Expression
+	Name  string
+}
+
+// NewAlias returns a new Alias instance.
+func NewAlias(value Expression, name string) Alias {
+	return Alias{
+		Value: value,
+		Name:  name,
+	}
+}
+
+// Asc is used to transform an alias to an order expression.
+func (alias Alias) Asc() Order {
+	return NewOrder(quote(alias.Name), types.Asc)
+}
+
+// Desc is used to transform an alias to an order expression.
+func (alias Alias) Desc() Order {
+	return NewOrder(quote(alias.Name), types.Desc)
+}
+
+// Write exposes statement as a SQL query.
+func (alias Alias) Write(ctx types.Context) {
+	if alias.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	alias.Value.Write(ctx)
+	ctx.Write(" ")
+	ctx.Write(token.As.String())
+	ctx.Write(" ")
+	ctx.Write(quote(alias.Name))
+}
+
+// IsEmpty returns true if statement is undefined.
+func (alias Alias) IsEmpty() bool {
+	return alias.Value == nil || alias.Value.IsEmpty() || alias.Name == ""
+}
+
+func (Alias) selectExpression() {}
+
+// Ensure that Alias is a SelectExpression
+var _ SelectExpression = Alias{}
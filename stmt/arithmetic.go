@@ -0,0 +1,168 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// ----------------------------------------------------------------------------
+// Arithmetic
+// ----------------------------------------------------------------------------
+
+// Arithmetic is an expression that computes a value from a left and right operand with an operator.
+// For example, the expression 'price * 2' or 'first_name || last_name' is an arithmetic expression.
+type Arithmetic struct {
+	Left     Expression
+	Operator ArithmeticOperator
+	Right    Expression
+}
+
+// NewArithmetic returns a new Arithmetic instance.
+func NewArithmetic(left Expression, operator types.ArithmeticOperator, right Expression) Arithmetic {
+	return Arithmetic{
+		Left:     left,
+		Operator: NewArithmeticOperator(operator),
+		Right:    right,
+	}
+}
+
+// As is used to give an alias name to the expression.
+func (arithmetic Arithmetic) As(alias string) Alias {
+	return NewAlias(arithmetic, alias)
+}
+
+// Asc is used to transform an arithmetic expression to an order expression.
+func (arithmetic Arithmetic) Asc() Order {
+	return NewExpressionOrder(arithmetic, types.Asc)
+}
+
+// Desc is used to transform an arithmetic expression to an order expression.
+func (arithmetic Arithmetic) Desc() Order {
+	return NewExpressionOrder(arithmetic, types.Desc)
+}
+
+func (Arithmetic) expression()       {}
+func (Arithmetic) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (arithmetic Arithmetic) Write(ctx types.Context) {
+	if arithmetic.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write("(")
+	arithmetic.Left.Write(ctx)
+	ctx.Write(" ")
+	arithmetic.Operator.Write(ctx)
+	ctx.Write(" ")
+	arithmetic.Right.Write(ctx)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (arithmetic Arithmetic) IsEmpty() bool {
+	return arithmetic.Left == nil || arithmetic.Right == nil ||
+		arithmetic.Left.IsEmpty() || arithmetic.Operator.IsEmpty() || arithmetic.Right.IsEmpty()
+}
+
+// Ensure that Arithmetic is an Expression
+var _ Expression = Arithmetic{}
+
+// Ensure that Arithmetic is a SelectExpression
+var _ SelectExpression = Arithmetic{}
+
+// ----------------------------------------------------------------------------
+// Unary
+// ----------------------------------------------------------------------------
+
+// Unary is an expression that has a single operand with a prefix operator.
+// For example, the expression '-amount' or 'NOT is_staff' is an unary expression.
+type Unary struct {
+	Operator string
+	Value    Expression
+}
+
+// NewNegate returns a new Unary instance using a minus sign.
+func NewNegate(value Expression) Unary {
+	return Unary{
+		Operator: types.Minus.String(),
+		Value:    value,
+	}
+}
+
+// NewNot returns a new Unary instance using a NOT operator.
+func NewNot(value Expression) Unary {
+	return Unary{
+		Operator: types.Not.String() + " ",
+		Value:    value,
+	}
+}
+
+// As is used to give an alias name to the expression.
+func (unary Unary) As(alias string) Alias {
+	return NewAlias(unary, alias)
+}
+
+// Asc is used to transform an unary expression to an order expression.
+func (unary Unary) Asc() Order {
+	return NewExpressionOrder(unary, types.Asc)
+}
+
+// Desc is used to transform an unary expression to an order expression.
+func (unary Unary) Desc() Order {
+	return NewExpressionOrder(unary, types.Desc)
+}
+
+func (Unary) expression()       {}
+func (Unary) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (unary Unary) Write(ctx types.Context) {
+	if unary.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write("(")
+	ctx.Write(unary.Operator)
+	if unary.Operator == types.Minus.String() && startsWithSign(unary.Value) {
+		// A negative literal right after a minus sign would start a comment.
+		ctx.Write("(")
+		unary.Value.Write(ctx)
+		ctx.Write(")")
+	} else {
+		unary.Value.Write(ctx)
+	}
+	ctx.Write(")")
+}
+
+// startsWithSign returns true if given expression may be written with a leading sign.
+func startsWithSign(value Expression) bool {
+	switch value.(type) {
+	case Value, NamedValue, Raw:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (unary Unary) IsEmpty() bool {
+	return unary.Operator == "" || unary.Value == nil || unary.Value.IsEmpty()
+}
+
+// And creates a new InfixExpression using given Expression.
+func (unary Unary) And(value Expression) InfixExpression {
+	operator := NewAndOperator()
+	return NewInfixExpression(unary, operator, value)
+}
+
+// Or creates a new InfixExpression using given Expression.
+func (unary Unary) Or(value Expression) InfixExpression {
+	operator := NewOrOperator()
+	return NewInfixExpression(unary, operator, value)
+}
+
+// Ensure that Unary is an Expression
+var _ Expression = Unary{}
+
+// Ensure that Unary is a SelectExpression
+var _ SelectExpression = Unary{}
@@ -0,0 +1,161 @@
+package stmt
+
+import (
+	"strconv"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// ----------------------------------------------------------------------------
+// ArrayConstructor
+// ----------------------------------------------------------------------------
+
+// ArrayConstructor is an array constructor, such as "ARRAY[a, b]" or "ARRAY(SELECT ...)".
+type ArrayConstructor struct {
+	Values []Expression
+}
+
+// NewArrayConstructor returns a new ArrayConstructor instance.
+func NewArrayConstructor(values ...Expression) ArrayConstructor {
+	return ArrayConstructor{
+		Values: values,
+	}
+}
+
+func (ArrayConstructor) expression()       {}
+func (ArrayConstructor) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+// An empty array has no element type to infer, so it must be written through a Cast.
+func (array ArrayConstructor) Write(ctx types.Context) {
+	if array.IsEmpty() {
+		panic("loukoum: empty array constructor requires a cast")
+	}
+
+	if len(array.Values) == 1 {
+		if query, ok := array.Values[0].(Select); ok {
+			ctx.Write("ARRAY(")
+			query.Write(ctx)
+			ctx.Write(")")
+			return
+		}
+	}
+
+	ctx.Write("ARRAY[")
+	for i := range array.Values {
+		if i > 0 {
+			ctx.Write(", ")
+		}
+		NewWrapper(array.Values[i]).Write(ctx)
+	}
+	ctx.Write("]")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (array ArrayConstructor) IsEmpty() bool {
+	return len(array.Values) == 0
+}
+
+// As is used to give an alias name to the array.
+func (array ArrayConstructor) As(alias string) Alias {
+	return NewAlias(array, alias)
+}
+
+// Ensure that ArrayConstructor is an Expression
+var _ Expression = ArrayConstructor{}
+
+// Ensure that ArrayConstructor is a SelectExpression
+var _ SelectExpression = ArrayConstructor{}
+
+// ----------------------------------------------------------------------------
+// Subscript
+// ----------------------------------------------------------------------------
+
+// Subscript is an array element or an array slice, such as "tags[1]" or "tags[2:3]".
+type Subscript struct {
+	Value Expression
+	Lower Expression
+	Upper Expression
+	Slice bool
+}
+
+// NewSubscript returns a new Subscript instance using given index.
+func NewSubscript(value Expression, index Expression) Subscript {
+	return Subscript{
+		Value: value,
+		Lower: index,
+	}
+}
+
+// NewSlice returns a new Subscript instance using given bounds, which are optional.
+func NewSlice(value Expression, lower, upper Expression) Subscript {
+	return Subscript{
+		Value: value,
+		Lower: lower,
+		Upper: upper,
+		Slice: true,
+	}
+}
+
+// SubscriptIndex returns the expression of an array index.
+// Integers are written as is so that the index keeps an integer type.
+func SubscriptIndex(index interface{}) Expression {
+	switch value := index.(type) {
+	case nil:
+		return nil
+	case int:
+		return NewRaw(strconv.FormatInt(int64(value), 10))
+	case int32:
+		return NewRaw(strconv.FormatInt(int64(value), 10))
+	case int64:
+		return NewRaw(strconv.FormatInt(value, 10))
+	default:
+		return NewExpression(value)
+	}
+}
+
+func (Subscript) expression() {}
+
+// Write exposes statement as a SQL query.
+func (subscript Subscript) Write(ctx types.Context) {
+	if subscript.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	switch value := subscript.Value.(type) {
+	case Column, Subscript:
+		value.Write(ctx)
+	case Identifier:
+		if _, ok := value.Identifier.(string); ok {
+			value.Write(ctx)
+		} else {
+			ctx.Write("(")
+			value.Write(ctx)
+			ctx.Write(")")
+		}
+	default:
+		ctx.Write("(")
+		value.Write(ctx)
+		ctx.Write(")")
+	}
+
+	ctx.Write("[")
+	if subscript.Lower != nil {
+		subscript.Lower.Write(ctx)
+	}
+	if subscript.Slice {
+		ctx.Write(":")
+		if subscript.Upper != nil {
+			subscript.Upper.Write(ctx)
+		}
+	}
+	ctx.Write("]")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (subscript Subscript) IsEmpty() bool {
+	return subscript.Value == nil || subscript.Value.IsEmpty() || (!subscript.Slice && subscript.Lower == nil)
+}
+
+// Ensure that Subscript is an Expression
+var _ Expression = Subscript{}
@@ -0,0 +1,100 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Case is a CASE expression.
+type Case struct {
+	Operand Expression
+	Whens   []When
+	Default Expression
+}
+
+// When is a WHEN clause of a CASE expression.
+type When struct {
+	Condition Expression
+	Result    Expression
+}
+
+// NewCase returns a new Case instance, evaluating each WHEN condition in order.
+func NewCase() Case {
+	return Case{}
+}
+
+// NewSimpleCase returns a new Case instance, comparing given operand to each WHEN value in order.
+func NewSimpleCase(operand Expression) Case {
+	return Case{
+		Operand: operand,
+	}
+}
+
+// When adds a WHEN clause to the expression.
+func (kase Case) When(condition interface{}, result interface{}) Case {
+	whens := make([]When, len(kase.Whens), len(kase.Whens)+1)
+	copy(whens, kase.Whens)
+	kase.Whens = append(whens, When{
+		Condition: NewExpression(condition),
+		Result:    NewExpression(result),
+	})
+	return kase
+}
+
+// Else defines the result of the expression if no WHEN clause is satisfied.
+func (kase Case) Else(result interface{}) Case {
+	kase.Default = NewExpression(result)
+	return kase
+}
+
+// As is used to give an alias name to the expression.
+func (kase Case) As(alias string) Alias {
+	return NewAlias(kase, alias)
+}
+
+// Asc is used to transform a case expression to an order expression.
+func (kase Case) Asc() Order {
+	return NewExpressionOrder(kase, types.Asc)
+}
+
+// Desc is used to transform a case expression to an order expression.
+func (kase Case) Desc() Order {
+	return NewExpressionOrder(kase, types.Desc)
+}
+
+func (Case) expression()       {}
+func (Case) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (kase Case) Write(ctx types.Context) {
+	if kase.IsEmpty() {
+		panic("loukoum: a case expression expects at least one when clause")
+	}
+
+	ctx.Write("CASE")
+	if kase.Operand != nil {
+		ctx.Write(" ")
+		kase.Operand.Write(ctx)
+	}
+	for i := range kase.Whens {
+		ctx.Write(" WHEN ")
+		kase.Whens[i].Condition.Write(ctx)
+		ctx.Write(" THEN ")
+		kase.Whens[i].Result.Write(ctx)
+	}
+	if kase.Default != nil {
+		ctx.Write(" ELSE ")
+		kase.Default.Write(ctx)
+	}
+	ctx.Write(" END")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (kase Case) IsEmpty() bool {
+	return len(kase.Whens) == 0
+}
+
+// Ensure that Case is an Expression
+var _ Expression = Case{}
+
+// Ensure that Case is a SelectExpression
+var _ SelectExpression = Case{}
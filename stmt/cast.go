@@ -0,0 +1,124 @@
+package stmt
+
+import (
+	"regexp"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// castTypePattern matches a type name, which may be schema-qualified, followed by an optional modifier
+// such as "(10,2)" and array suffixes.
+// Words following the name are restricted to the keywords of multi-word types, such as "double precision"
+// or "timestamp(3) with time zone", since the shorthand syntax doesn't enclose the type.
+var castTypePattern = regexp.MustCompile(
+	`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?` +
+		`( (?i:precision|varying|with|without|time|zone|to|year|month|day|hour|minute|second))*` +
+		`(\([0-9]+(, ?[0-9]+)?\))?` +
+		`( (?i:with|without|time|zone|to|year|month|day|hour|minute|second))*` +
+		`(\[[0-9]*\])*$`,
+)
+
+// Cast is a type cast expression.
+// It uses the CAST(value AS type) syntax by default: a "::" following a named placeholder could be
+// mistaken for another parameter.
+type Cast struct {
+	Value     Expression
+	Type      string
+	Shorthand bool
+}
+
+// NewCast returns a new Cast instance.
+func NewCast(value Expression, kind string) Cast {
+	return Cast{
+		Value: value,
+		Type:  kind,
+	}
+}
+
+// UseShorthand configures the cast to use PostgreSQL "::" syntax.
+// Since named queries don't support this syntax, CAST(value AS type) is still used on a named context.
+func (cast Cast) UseShorthand() Cast {
+	cast.Shorthand = true
+	return cast
+}
+
+// As is used to give an alias name to the expression.
+func (cast Cast) As(alias string) Alias {
+	return NewAlias(cast, alias)
+}
+
+// Asc is used to transform a cast expression to an order expression.
+func (cast Cast) Asc() Order {
+	return NewExpressionOrder(cast, types.Asc)
+}
+
+// Desc is used to transform a cast expression to an order expression.
+func (cast Cast) Desc() Order {
+	return NewExpressionOrder(cast, types.Desc)
+}
+
+func (Cast) expression()       {}
+func (Cast) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (cast Cast) Write(ctx types.Context) {
+	if cast.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+	if !castTypePattern.MatchString(cast.Type) {
+		panic("loukoum: invalid type for cast expression")
+	}
+	if isEmptyArray(cast.Value) {
+		cast.Value = NewRaw("ARRAY[]")
+	}
+
+	named := isNamedContext(ctx)
+	if cast.Shorthand && !named {
+		if isSimpleOperand(cast.Value) {
+			cast.Value.Write(ctx)
+		} else {
+			ctx.Write("(")
+			cast.Value.Write(ctx)
+			ctx.Write(")")
+		}
+		ctx.Write("::")
+		ctx.Write(cast.Type)
+		return
+	}
+
+	ctx.Write("CAST(")
+	cast.Value.Write(ctx)
+	ctx.Write(" AS ")
+	ctx.Write(cast.Type)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (cast Cast) IsEmpty() bool {
+	return cast.Value == nil || (cast.Value.IsEmpty() && !isEmptyArray(cast.Value)) || cast.Type == ""
+}
+
+// isEmptyArray returns true if given expression is an array constructor without elements.
+func isEmptyArray(expression Expression) bool {
+	array, ok := expression.(ArrayConstructor)
+	return ok && array.IsEmpty()
+}
+
+// isSimpleOperand returns true if given expression doesn't require parenthesis to be used as an operand.
+func isSimpleOperand(expression Expression) bool {
+	switch value := expression.(type) {
+	case Column, Value, Call:
+		return true
+	case Identifier:
+		_, ok := value.Identifier.(string)
+		return ok
+	default:
+		return false
+	}
+}
+
+// Ensure that Cast is an Expression
+var _ Expression = Cast{}
+
+// Ensure that Cast is a SelectExpression
+var _ SelectExpression = Cast{}
@@ -0,0 +1,98 @@
+package stmt
+
+import (
+	"reflect"
+)
+
+var stmtPackage = reflect.TypeOf(Select{}).PkgPath()
+
+// Clone returns a deep copy of given statement: slices and maps of the statement and of its nodes are
+// copied, so that the copy can be modified without altering the original.
+// Values given as query arguments are not copied.
+func Clone[T Statement](statement T) T {
+	value := reflect.ValueOf(&statement).Elem()
+	return cloneValue(value).Interface().(T)
+}
+
+func cloneValue(value reflect.Value) reflect.Value { // nolint: gocyclo
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() || !isNode(value.Elem().Type()) {
+			return value
+		}
+		clone := reflect.New(value.Type()).Elem()
+		clone.Set(cloneValue(value.Elem()))
+		return clone
+
+	case reflect.Ptr:
+		if value.IsNil() || !isNode(value.Type()) {
+			return value
+		}
+		clone := reflect.New(value.Type().Elem())
+		clone.Elem().Set(cloneValue(value.Elem()))
+		return clone
+
+	case reflect.Struct:
+		if !isNode(value.Type()) {
+			return value
+		}
+		// Unexported fields are copied as is, then renewed by the node if it needs it.
+		clone := reflect.New(value.Type()).Elem()
+		clone.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				clone.Field(i).Set(cloneValue(value.Field(i)))
+			}
+		}
+		if node, ok := clone.Interface().(cloner); ok {
+			clone.Set(reflect.ValueOf(node.cloneUnexported()))
+		}
+		if node, ok := clone.Interface().(comparer); ok {
+			clone.Set(reflect.ValueOf(node.renewComparison()))
+		}
+		return clone
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		clone := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			clone.Index(i).Set(cloneValue(value.Index(i)))
+		}
+		return clone
+
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		clone := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iterator := value.MapRange()
+		for iterator.Next() {
+			clone.SetMapIndex(cloneValue(iterator.Key()), cloneValue(iterator.Value()))
+		}
+		return clone
+
+	default:
+		return value
+	}
+}
+
+// A cloner is a node which copies its unexported fields itself.
+type cloner interface {
+	Expression
+	cloneUnexported() Expression
+}
+
+func (call Call) cloneUnexported() Expression {
+	call.args = cloneValue(reflect.ValueOf(call.args)).Interface().([]Expression)
+	return call
+}
+
+// isNode returns true if given type is a statement node, or a pointer to a statement node.
+func isNode(kind reflect.Type) bool {
+	if kind.Kind() == reflect.Ptr {
+		kind = kind.Elem()
+	}
+	return kind.Kind() == reflect.Struct && kind.PkgPath() == stmtPackage
+}
@@ -24,6 +24,12 @@ func NewColumnAlias(name, alias string) Column {
 	}
 }
 
+// NewExcludedColumn returns a new Column instance referencing the value proposed for insertion,
+// in a ON CONFLICT DO UPDATE clause.
+func NewExcludedColumn(name string) Column {
+	return NewColumn("excluded." + name)
+}
+
 // As is used to give an alias name to the column.
 func (column Column) As(alias string) Column {
 	column.Alias = alias
@@ -51,14 +57,24 @@ func (column Column) Desc() Order {
 }
 
 // Write exposes statement as a SQL query.
+// Alias is only written in a projection, since a column is also used as an operand.
 func (column Column) Write(ctx types.Context) {
 	ctx.Write(quote(column.Name))
-	if column.Alias != "" {
-		ctx.Write(" ")
-		ctx.Write(token.As.String())
-		ctx.Write(" ")
-		ctx.Write(quote(column.Alias))
+}
+
+// writeProjection writes given expression of a select list or a returning clause, with its alias if it's
+// an aliased column.
+func writeProjection(ctx types.Context, expression SelectExpression) {
+	column, ok := expression.(Column)
+	if !ok || column.Alias == "" {
+		expression.Write(ctx)
+		return
 	}
+	column.Write(ctx)
+	ctx.Write(" ")
+	ctx.Write(token.As.String())
+	ctx.Write(" ")
+	ctx.Write(quote(column.Alias))
 }
 
 // IsEmpty returns true if statement is undefined.
@@ -66,7 +82,11 @@ func (column Column) IsEmpty() bool {
 	return column.Name == ""
 }
 
+func (Column) expression()       {}
 func (Column) selectExpression() {}
 
+// Ensure that Column is an Expression.
+var _ Expression = Column{}
+
 // Ensure that Column is a SelectExpression.
 var _ SelectExpression = Column{}
@@ -0,0 +1,17 @@
+package stmt
+
+// comparison is embedded in an expression to expose the comparison methods of an Identifier, such as Equal or
+// Between, using the expression itself as left operand.
+type comparison = Identifier
+
+// A comparer is an expression embedding a comparison, which holds a copy of the expression: it must be renewed
+// whenever the expression changes.
+type comparer interface {
+	Expression
+	renewComparison() Expression
+}
+
+// withComparison returns given expression with a comparison using its current state.
+func withComparison[T comparer](expression T) T {
+	return expression.renewComparison().(T)
+}
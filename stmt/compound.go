@@ -0,0 +1,73 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Compound combines the results of several queries with an operator such as UNION or EXCEPT.
+type Compound struct {
+	Operator types.CompoundOperator
+	Queries  []Expression
+}
+
+// NewCompound returns a new Compound instance.
+func NewCompound(operator types.CompoundOperator, queries ...Expression) Compound {
+	return Compound{
+		Operator: operator,
+		Queries:  queries,
+	}
+}
+
+func (Compound) expression() {}
+
+// Write exposes statement as a SQL query.
+func (compound Compound) Write(ctx types.Context) {
+	if compound.IsEmpty() {
+		panic("loukoum: a compound query requires at least two queries")
+	}
+
+	for i := range compound.Queries {
+		if i != 0 {
+			ctx.Write(" ")
+			ctx.Write(compound.Operator.String())
+			ctx.Write(" ")
+		}
+		if isCompoundOperand(compound.Queries[i]) {
+			compound.Queries[i].Write(ctx)
+		} else {
+			ctx.Write("(")
+			compound.Queries[i].Write(ctx)
+			ctx.Write(")")
+		}
+	}
+}
+
+// isCompoundOperand returns true if given query can be combined without parenthesis: otherwise, a clause such
+// as ORDER BY or LIMIT would apply to the whole compound query.
+func isCompoundOperand(query Expression) bool {
+	switch value := query.(type) {
+	case Select:
+		return value.With.IsEmpty() && value.OrderBy.IsEmpty() && value.Limit.IsEmpty() &&
+			value.Offset.IsEmpty() && value.Suffix.IsEmpty()
+	case Compound:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (compound Compound) IsEmpty() bool {
+	if compound.Operator == "" || len(compound.Queries) < 2 {
+		return true
+	}
+	for i := range compound.Queries {
+		if compound.Queries[i] == nil || compound.Queries[i].IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure that Compound is an Expression
+var _ Expression = Compound{}
@@ -38,14 +38,30 @@ func (conflict OnConflict) Write(ctx types.Context) {
 	conflict.Action.Write(ctx)
 }
 
+// resolve returns a copy of the expression with its action using given inserted columns, if required.
+func (conflict OnConflict) resolve(columns []Column) OnConflict {
+	action, ok := conflict.Action.(ConflictUpdateAction)
+	if ok {
+		conflict.Action = action.resolve(columns)
+	}
+	return conflict
+}
+
 // IsEmpty returns true if statement is undefined.
 func (conflict OnConflict) IsEmpty() bool {
 	return conflict.Action == nil || conflict.Action.IsEmpty()
 }
 
-// ConflictTarget is a column identifier.
+// ConflictTarget is the arbiter used by ON CONFLICT expression.
+// It can be either a list of index columns and expressions with an optional partial index predicate,
+// or a constraint name.
+// Index items are stored in Columns if they are all columns, otherwise in Expressions, columns included,
+// so that they are written in their order.
 type ConflictTarget struct {
-	Columns []Column
+	Columns     []Column
+	Expressions []Expression
+	Condition   Where
+	Constraint  string
 }
 
 // NewConflictTarget returns a new ConflictTarget instance.
@@ -55,12 +71,43 @@ func NewConflictTarget(columns []Column) ConflictTarget {
 	}
 }
 
+// NewConflictTargetExpressions returns a new ConflictTarget instance using given index expressions.
+func NewConflictTargetExpressions(expressions []Expression) ConflictTarget {
+	return ConflictTarget{
+		Expressions: expressions,
+	}
+}
+
+// NewConflictConstraint returns a new ConflictTarget instance using given constraint name.
+func NewConflictConstraint(name string) ConflictTarget {
+	return ConflictTarget{
+		Constraint: name,
+	}
+}
+
+// Where adds a partial index predicate, to infer a partial unique index as the arbiter.
+func (target ConflictTarget) Where(condition Expression) ConflictTarget {
+	if target.Condition.IsEmpty() {
+		target.Condition = NewWhere(condition)
+		return target
+	}
+	target.Condition = target.Condition.And(condition)
+	return target
+}
+
 // Write exposes statement as a SQL query.
 func (target ConflictTarget) Write(ctx types.Context) {
 	if target.IsEmpty() {
 		return
 	}
 
+	if target.Constraint != "" {
+		ctx.Write(token.On.String())
+		ctx.Write(" CONSTRAINT ")
+		ctx.Write(quote(target.Constraint))
+		return
+	}
+
 	ctx.Write("(")
 	for i := range target.Columns {
 		if i != 0 {
@@ -68,12 +115,30 @@ func (target ConflictTarget) Write(ctx types.Context) {
 		}
 		target.Columns[i].Write(ctx)
 	}
+	for i := range target.Expressions {
+		if i != 0 || len(target.Columns) != 0 {
+			ctx.Write(", ")
+		}
+		switch target.Expressions[i].(type) {
+		case Column, Call:
+			target.Expressions[i].Write(ctx)
+		default:
+			ctx.Write("(")
+			target.Expressions[i].Write(ctx)
+			ctx.Write(")")
+		}
+	}
 	ctx.Write(")")
+
+	if !target.Condition.IsEmpty() {
+		ctx.Write(" ")
+		target.Condition.Write(ctx)
+	}
 }
 
 // IsEmpty returns true if statement is undefined.
 func (target ConflictTarget) IsEmpty() bool {
-	return len(target.Columns) == 0
+	return len(target.Columns) == 0 && len(target.Expressions) == 0 && target.Constraint == ""
 }
 
 // ConflictAction is a action used by ON CONFLICT expression.
@@ -85,7 +150,10 @@ type ConflictAction interface {
 
 // ConflictUpdateAction is a DO UPDATE clause on ON CONFLICT expression.
 type ConflictUpdateAction struct {
-	Set Set
+	Set       Set
+	Condition Where
+	Excluded  bool
+	Except    []Column
 }
 
 // NewConflictUpdateAction returns a new ConflictUpdateAction instance.
@@ -95,18 +163,78 @@ func NewConflictUpdateAction(set Set) ConflictUpdateAction {
 	}
 }
 
+// NewConflictUpdateExcludedAction returns a new ConflictUpdateAction instance, which assigns every inserted
+// column, except given ones, to its value proposed for insertion (using EXCLUDED table).
+func NewConflictUpdateExcludedAction(except []Column) ConflictUpdateAction {
+	return ConflictUpdateAction{
+		Set:      NewSet(),
+		Excluded: true,
+		Except:   except,
+	}
+}
+
+// Where adds a condition on the existing row that must be satisfied to update it.
+func (action ConflictUpdateAction) Where(condition Expression) ConflictUpdateAction {
+	if action.Condition.IsEmpty() {
+		action.Condition = NewWhere(condition)
+		return action
+	}
+	action.Condition = action.Condition.And(condition)
+	return action
+}
+
+// resolve returns a copy of the action with its SET clause using given inserted columns, if required.
+func (action ConflictUpdateAction) resolve(columns []Column) ConflictUpdateAction {
+	if !action.Excluded {
+		return action
+	}
+
+	set := NewSet()
+	for i := range columns {
+		if !containsColumn(action.Except, columns[i]) {
+			set.Pairs.Add(columns[i], NewExcludedColumn(columns[i].Name))
+		}
+	}
+
+	if set.IsEmpty() {
+		panic("loukoum: on conflict update clause requires inserted columns")
+	}
+
+	action.Set = set
+	action.Excluded = false
+	return action
+}
+
+func containsColumn(columns []Column, column Column) bool {
+	for i := range columns {
+		if columns[i].Name == column.Name {
+			return true
+		}
+	}
+	return false
+}
+
 // Write exposes statement as a SQL query.
 func (action ConflictUpdateAction) Write(ctx types.Context) {
+	if action.Excluded {
+		panic("loukoum: on conflict update clause requires inserted columns")
+	}
+
 	ctx.Write(token.Do.String())
 	ctx.Write(" ")
 	ctx.Write(token.Update.String())
 	ctx.Write(" ")
 	action.Set.Write(ctx)
+
+	if !action.Condition.IsEmpty() {
+		ctx.Write(" ")
+		action.Condition.Write(ctx)
+	}
 }
 
 // IsEmpty returns true if statement is undefined.
 func (action ConflictUpdateAction) IsEmpty() bool {
-	return action.Set.IsEmpty()
+	return action.Set.IsEmpty() && !action.Excluded
 }
 
 func (ConflictUpdateAction) conflictAction() {}
@@ -0,0 +1,165 @@
+package stmt
+
+import (
+	"strings"
+
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Copy is a COPY statement.
+//
+// Since PostgreSQL doesn't accept bind parameters in a COPY statement, values of the source query
+// are always embedded in the query as escaped literals, whatever the given context.
+type Copy struct {
+	Table   Table
+	Columns []Column
+	Query   Expression
+	From    bool
+	Options CopyOptions
+}
+
+// NewCopyFrom returns a new Copy instance loading rows in given table from standard input.
+func NewCopyFrom(table Table, columns []Column) Copy {
+	return Copy{
+		Table:   table,
+		Columns: columns,
+		From:    true,
+	}
+}
+
+// NewCopyTo returns a new Copy instance writing rows of given table on standard output.
+func NewCopyTo(table Table, columns []Column) Copy {
+	return Copy{
+		Table:   table,
+		Columns: columns,
+	}
+}
+
+// NewCopyQueryTo returns a new Copy instance writing rows of given query on standard output.
+func NewCopyQueryTo(query Expression) Copy {
+	return Copy{
+		Query: query,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (statement Copy) Write(ctx types.Context) {
+	if statement.IsEmpty() {
+		panic("loukoum: a copy statement must have a table or a query")
+	}
+	if statement.Query != nil && statement.From {
+		panic("loukoum: a copy statement cannot load rows into a query")
+	}
+
+	ctx.Write(token.Copy.String())
+	ctx.Write(" ")
+
+	if statement.Query != nil {
+		ctx.Write(token.LParen.String())
+		statement.Query.Write(&literalContext{ctx})
+		ctx.Write(token.RParen.String())
+	} else {
+		statement.Table.Write(ctx)
+		if len(statement.Columns) > 0 {
+			ctx.Write(" ")
+			writeCopyColumns(ctx, statement.Columns)
+		}
+	}
+
+	ctx.Write(" ")
+	if statement.From {
+		ctx.Write(token.From.String())
+		ctx.Write(" ")
+		ctx.Write(token.Stdin.String())
+	} else {
+		ctx.Write(token.To.String())
+		ctx.Write(" ")
+		ctx.Write(token.Stdout.String())
+	}
+
+	if !statement.Options.IsEmpty() {
+		ctx.Write(" ")
+		ctx.Write(token.With.String())
+		ctx.Write(" ")
+		statement.Options.Write(ctx)
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (statement Copy) IsEmpty() bool {
+	if statement.Query != nil {
+		return statement.Query.IsEmpty()
+	}
+	return statement.Table.IsEmpty()
+}
+
+// Ensure that Copy is a Statement
+var _ Statement = Copy{}
+
+// CopyOptions are the options of a COPY statement.
+type CopyOptions struct {
+	Format        types.CopyFormat
+	Header        bool
+	Delimiter     string
+	Null          string
+	HasNull       bool
+	Quote         string
+	ForceQuote    []Column
+	ForceQuoteAll bool
+}
+
+// Write exposes statement as a SQL query.
+func (options CopyOptions) Write(ctx types.Context) {
+	list := []string{}
+
+	if options.Format != "" {
+		list = append(list, "FORMAT "+options.Format.String())
+	}
+	if options.Header {
+		list = append(list, "HEADER true")
+	}
+	if options.Delimiter != "" {
+		list = append(list, "DELIMITER "+escapeLiteral(options.Delimiter))
+	}
+	if options.HasNull {
+		list = append(list, "NULL "+escapeLiteral(options.Null))
+	}
+	if options.Quote != "" {
+		list = append(list, "QUOTE "+escapeLiteral(options.Quote))
+	}
+
+	ctx.Write(token.LParen.String())
+	ctx.Write(strings.Join(list, ", "))
+
+	if options.ForceQuoteAll || len(options.ForceQuote) > 0 {
+		if len(list) > 0 {
+			ctx.Write(", ")
+		}
+		ctx.Write("FORCE_QUOTE ")
+		if options.ForceQuoteAll {
+			ctx.Write(token.Asterisk.String())
+		} else {
+			writeCopyColumns(ctx, options.ForceQuote)
+		}
+	}
+
+	ctx.Write(token.RParen.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (options CopyOptions) IsEmpty() bool {
+	return options.Format == "" && !options.Header && options.Delimiter == "" && !options.HasNull &&
+		options.Quote == "" && len(options.ForceQuote) == 0 && !options.ForceQuoteAll
+}
+
+func writeCopyColumns(ctx types.Context, columns []Column) {
+	ctx.Write(token.LParen.String())
+	for i := range columns {
+		if i != 0 {
+			ctx.Write(", ")
+		}
+		columns[i].Write(ctx)
+	}
+	ctx.Write(token.RParen.String())
+}
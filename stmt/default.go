@@ -0,0 +1,29 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Default is a DEFAULT expression, used to assign its default value to a column in an INSERT or an UPDATE.
+type Default struct{}
+
+// NewDefault returns a new Default instance.
+func NewDefault() Default {
+	return Default{}
+}
+
+func (Default) expression() {}
+
+// Write exposes statement as a SQL query.
+func (Default) Write(ctx types.Context) {
+	ctx.Write(token.Default.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (Default) IsEmpty() bool {
+	return false
+}
+
+// Ensure that Default is an Expression
+var _ Expression = Default{}
@@ -7,6 +7,7 @@ import (
 
 // Delete is a DELETE statement.
 type Delete struct {
+	With      With
 	From      From
 	Using     Using
 	Where     Where
@@ -19,12 +20,19 @@ func NewDelete() Delete {
 	return Delete{}
 }
 
+func (Delete) expression() {}
+
 // Write exposes statement as a SQL query.
 func (delete Delete) Write(ctx types.Context) {
 	if delete.IsEmpty() {
 		panic("loukoum: a delete statement must have a table")
 	}
 
+	if !delete.With.IsEmpty() {
+		delete.With.Write(ctx)
+		ctx.Write(" ")
+	}
+
 	ctx.Write(token.Delete.String())
 	ctx.Write(" ")
 	delete.From.Write(ctx)
@@ -56,5 +64,5 @@ func (delete Delete) IsEmpty() bool {
 	return delete.From.IsEmpty()
 }
 
-// Ensure that Delete is a Statement
-var _ Statement = Delete{}
+// Ensure that Delete is an Expression
+var _ Expression = Delete{}
@@ -0,0 +1,98 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// DerivedTable is a subquery, a VALUES list or a set-returning function used as a table,
+// in a FROM, a USING or a JOIN clause.
+type DerivedTable struct {
+	Source        Expression
+	Alias         string
+	Columns       []string
+	IsLateral     bool
+	HasOrdinality bool
+}
+
+// NewDerivedTable returns a new DerivedTable instance.
+func NewDerivedTable(source Expression, alias string, columns ...string) DerivedTable {
+	return DerivedTable{
+		Source:  source,
+		Alias:   alias,
+		Columns: columns,
+	}
+}
+
+// As is used to give an alias name to the derived table, and optionally to its columns.
+func (table DerivedTable) As(alias string, columns ...string) DerivedTable {
+	table.Alias = alias
+	table.Columns = columns
+	return table
+}
+
+// Lateral sets LATERAL keyword to the derived table, so it can reference columns of preceding items.
+func (table DerivedTable) Lateral() DerivedTable {
+	table.IsLateral = true
+	return table
+}
+
+// WithOrdinality sets WITH ORDINALITY clause to a set-returning function, which adds a column numbering its rows.
+func (table DerivedTable) WithOrdinality() DerivedTable {
+	table.HasOrdinality = true
+	return table
+}
+
+// Write exposes statement as a SQL query.
+func (table DerivedTable) Write(ctx types.Context) {
+	if table.Source == nil || table.Source.IsEmpty() {
+		panic("loukoum: derived table is undefined")
+	}
+	if table.Alias == "" {
+		panic("loukoum: derived table requires an alias")
+	}
+
+	if table.IsLateral {
+		ctx.Write(token.Lateral.String())
+		ctx.Write(" ")
+	}
+
+	switch table.Source.(type) {
+	case Call:
+		table.Source.Write(ctx)
+		if table.HasOrdinality {
+			ctx.Write(" WITH ORDINALITY")
+		}
+	default:
+		if table.HasOrdinality {
+			panic("loukoum: with ordinality requires a set-returning function")
+		}
+		ctx.Write("(")
+		table.Source.Write(ctx)
+		ctx.Write(")")
+	}
+
+	ctx.Write(" ")
+	ctx.Write(token.As.String())
+	ctx.Write(" ")
+	ctx.Write(quote(table.Alias))
+
+	if len(table.Columns) > 0 {
+		ctx.Write("(")
+		for i := range table.Columns {
+			if i != 0 {
+				ctx.Write(", ")
+			}
+			ctx.Write(quote(table.Columns[i]))
+		}
+		ctx.Write(")")
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (table DerivedTable) IsEmpty() bool {
+	return table.Source == nil || table.Source.IsEmpty() || table.Alias == ""
+}
+
+// Ensure that DerivedTable is a Statement
+var _ Statement = DerivedTable{}
@@ -0,0 +1,94 @@
+package stmt
+
+import (
+	"strings"
+
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Explain is an EXPLAIN statement.
+type Explain struct {
+	Statement Statement
+	Options   ExplainOptions
+}
+
+// NewExplain returns a new Explain instance.
+func NewExplain(statement Statement, options ExplainOptions) Explain {
+	return Explain{
+		Statement: statement,
+		Options:   options,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (explain Explain) Write(ctx types.Context) {
+	if explain.IsEmpty() {
+		panic("loukoum: an explain statement must have a statement")
+	}
+
+	ctx.Write(token.Explain.String())
+	ctx.Write(" ")
+
+	if !explain.Options.IsEmpty() {
+		explain.Options.Write(ctx)
+		ctx.Write(" ")
+	}
+
+	explain.Statement.Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (explain Explain) IsEmpty() bool {
+	return explain.Statement == nil || explain.Statement.IsEmpty()
+}
+
+// Ensure that Explain is a Statement
+var _ Statement = Explain{}
+
+// ExplainOptions are the options of an EXPLAIN statement.
+//
+// Keep in mind that with Analyze, the statement is actually executed: wrap data-modifying
+// statements in a transaction that is rolled back afterwards.
+type ExplainOptions struct {
+	Analyze  bool
+	Buffers  bool
+	Format   types.ExplainFormat
+	Verbose  bool
+	Settings bool
+}
+
+// Write exposes statement as a SQL query.
+func (options ExplainOptions) Write(ctx types.Context) {
+	list := []string{}
+
+	if options.Analyze {
+		list = append(list, "ANALYZE")
+	}
+	if options.Buffers {
+		list = append(list, "BUFFERS")
+	}
+	if options.Format != "" {
+		switch options.Format {
+		case types.ExplainText, types.ExplainJSON, types.ExplainXML, types.ExplainYAML:
+		default:
+			panic("loukoum: unknown explain format")
+		}
+		list = append(list, "FORMAT "+options.Format.String())
+	}
+	if options.Verbose {
+		list = append(list, "VERBOSE")
+	}
+	if options.Settings {
+		list = append(list, "SETTINGS")
+	}
+
+	ctx.Write(token.LParen.String())
+	ctx.Write(strings.Join(list, ", "))
+	ctx.Write(token.RParen.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (options ExplainOptions) IsEmpty() bool {
+	return !options.Analyze && !options.Buffers && options.Format == "" && !options.Verbose && !options.Settings
+}
@@ -3,9 +3,12 @@ package stmt
 import (
 	"database/sql/driver"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/ulule/loukoum/v3/token"
 	"github.com/ulule/loukoum/v3/types"
 )
 
@@ -78,54 +81,75 @@ func (identifier Identifier) Write(ctx types.Context) {
 		ctx.Write(quote(t))
 	case Raw:
 		t.Write(ctx)
+	case Expression:
+		NewWrapper(t).Write(ctx)
+	case StatementEncoder:
+		NewWrapper(NewExpression(t)).Write(ctx)
+	case nil:
+		panic("loukoum: identifier is undefined")
 	}
 }
 
 // IsEmpty returns true if statement is undefined.
 func (identifier Identifier) IsEmpty() bool {
+	switch t := identifier.Identifier.(type) {
+	case Expression:
+		return t.IsEmpty()
+	case StatementEncoder:
+		return t.Statement().IsEmpty()
+	}
 	return identifier.Identifier == ""
 }
 
+// operand returns given value as the right operand of a comparison.
+// A slice of values compared to a Row is converted to a Row.
+func (identifier Identifier) operand(value interface{}) Expression {
+	if _, ok := identifier.Identifier.(Row); ok {
+		value = toRowOperand(value)
+	}
+	return NewWrapper(NewExpression(value))
+}
+
 // Contains performs a "contains" comparison.
 func (identifier Identifier) Contains(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.Contains)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // IsContainedBy performs a "is contained by" comparison.
 func (identifier Identifier) IsContainedBy(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.IsContainedBy)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // Overlap performs an "overlap" comparison.
 func (identifier Identifier) Overlap(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.Overlap)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // Equal performs an "equal" comparison.
 func (identifier Identifier) Equal(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.Equal)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // NotEqual performs a "not equal" comparison.
 func (identifier Identifier) NotEqual(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.NotEqual)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // Is performs a "is" comparison.
 func (identifier Identifier) Is(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.Is)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // IsNot performs a "is not" comparison.
 func (identifier Identifier) IsNot(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.IsNot)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // IsNull performs a "is null" comparison.
@@ -136,84 +160,312 @@ func (identifier Identifier) IsNull(value bool) InfixExpression {
 	return identifier.IsNot(nil)
 }
 
+// IsTrue performs a "is true" comparison.
+func (identifier Identifier) IsTrue() InfixExpression {
+	return identifier.Is(NewRaw(token.True.String()))
+}
+
+// IsNotTrue performs a "is not true" comparison.
+func (identifier Identifier) IsNotTrue() InfixExpression {
+	return identifier.IsNot(NewRaw(token.True.String()))
+}
+
+// IsFalse performs a "is false" comparison.
+func (identifier Identifier) IsFalse() InfixExpression {
+	return identifier.Is(NewRaw(token.False.String()))
+}
+
+// IsNotFalse performs a "is not false" comparison.
+func (identifier Identifier) IsNotFalse() InfixExpression {
+	return identifier.IsNot(NewRaw(token.False.String()))
+}
+
+// IsUnknown performs a "is unknown" comparison.
+func (identifier Identifier) IsUnknown() InfixExpression {
+	return identifier.Is(NewRaw(token.Unknown.String()))
+}
+
+// IsNotUnknown performs a "is not unknown" comparison.
+func (identifier Identifier) IsNotUnknown() InfixExpression {
+	return identifier.IsNot(NewRaw(token.Unknown.String()))
+}
+
+// Not negates the identifier, using a NOT operator.
+func (identifier Identifier) Not() Unary {
+	return NewNot(identifier)
+}
+
 // GreaterThan performs a "greater than" comparison.
 func (identifier Identifier) GreaterThan(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.GreaterThan)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // GreaterThanOrEqual performs a "greater than or equal to" comparison.
 func (identifier Identifier) GreaterThanOrEqual(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.GreaterThanOrEqual)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // LessThan performs a "less than" comparison.
 func (identifier Identifier) LessThan(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.LessThan)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // LessThanOrEqual performs a "less than or equal to" comparison.
 func (identifier Identifier) LessThanOrEqual(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.LessThanOrEqual)
-	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // In performs a "in" condition.
 func (identifier Identifier) In(value ...interface{}) In {
-	return NewIn(identifier, NewArrayExpression(value...))
+	return NewIn(identifier, newInValues(value))
 }
 
 // NotIn performs a "not in" condition.
 func (identifier Identifier) NotIn(value ...interface{}) In {
-	return NewNotIn(identifier, NewArrayExpression(value...))
+	return NewNotIn(identifier, newInValues(value))
+}
+
+// InArray performs a "in" condition binding given slice as a single array parameter, using
+// "= ANY(...)", so the query doesn't change with the length of the slice.
+func (identifier Identifier) InArray(value interface{}) InfixExpression {
+	return identifier.Equal(NewAny(NewArrayParameter(value)))
+}
+
+// NotInArray performs a "not in" condition binding given slice as a single array parameter, using
+// "!= ALL(...)", so the query doesn't change with the length of the slice.
+func (identifier Identifier) NotInArray(value interface{}) InfixExpression {
+	return identifier.NotEqual(NewAll(NewArrayParameter(value)))
 }
 
 // Like performs a "like" condition.
 func (identifier Identifier) Like(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.Like)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // NotLike performs a "not like" condition.
 func (identifier Identifier) NotLike(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.NotLike)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // ILike performs a "ilike" condition.
 func (identifier Identifier) ILike(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.ILike)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // NotILike performs a "not ilike" condition.
 func (identifier Identifier) NotILike(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.NotILike)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// LikeEscape performs a "like" condition, using given escape character instead of a backslash.
+// An empty escape character disables the escape mechanism.
+func (identifier Identifier) LikeEscape(value interface{}, escape string) InfixExpression {
+	operator := NewComparisonOperator(types.Like)
+	return NewInfixExpression(identifier, operator, NewLikeEscape(identifier.operand(value), escape))
+}
+
+// ILikeEscape performs a "ilike" condition, using given escape character instead of a backslash.
+// An empty escape character disables the escape mechanism.
+func (identifier Identifier) ILikeEscape(value interface{}, escape string) InfixExpression {
+	operator := NewComparisonOperator(types.ILike)
+	return NewInfixExpression(identifier, operator, NewLikeEscape(identifier.operand(value), escape))
+}
+
+// StartsWith performs a "like" condition matching values starting with given string.
+// Wildcards of given string are escaped.
+func (identifier Identifier) StartsWith(value string) InfixExpression {
+	return identifier.Like(EscapeLike(value) + "%")
+}
+
+// EndsWith performs a "like" condition matching values ending with given string.
+// Wildcards of given string are escaped.
+func (identifier Identifier) EndsWith(value string) InfixExpression {
+	return identifier.Like("%" + EscapeLike(value))
+}
+
+// ContainsText performs a "like" condition matching values containing given string.
+// Wildcards of given string are escaped.
+func (identifier Identifier) ContainsText(value string) InfixExpression {
+	return identifier.Like("%" + EscapeLike(value) + "%")
+}
+
+// SimilarTo performs a "similar to" condition.
+func (identifier Identifier) SimilarTo(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.SimilarTo)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// NotSimilarTo performs a "not similar to" condition.
+func (identifier Identifier) NotSimilarTo(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.NotSimilarTo)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// MatchRegex performs a case-sensitive posix regular expression match, using a "~" operator.
+func (identifier Identifier) MatchRegex(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.RegexMatch)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// IMatchRegex performs a case-insensitive posix regular expression match, using a "~*" operator.
+func (identifier Identifier) IMatchRegex(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.RegexIMatch)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// NotMatchRegex performs a case-sensitive posix regular expression mismatch, using a "!~" operator.
+func (identifier Identifier) NotMatchRegex(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.NotRegexMatch)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// NotIMatchRegex performs a case-insensitive posix regular expression mismatch, using a "!~*" operator.
+func (identifier Identifier) NotIMatchRegex(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.NotRegexIMatch)
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // Between performs a "between" condition.
 func (identifier Identifier) Between(from, to interface{}) Between {
-	return NewBetween(identifier, NewExpression(from), NewExpression(to))
+	return NewBetween(identifier, NewWrapper(NewExpression(from)), NewWrapper(NewExpression(to)))
 }
 
 // NotBetween performs a "not between" condition.
 func (identifier Identifier) NotBetween(from, to interface{}) Between {
-	return NewNotBetween(identifier, NewExpression(from), NewExpression(to))
+	return NewNotBetween(identifier, NewWrapper(NewExpression(from)), NewWrapper(NewExpression(to)))
 }
 
 // IsDistinctFrom performs an "is distinct from" comparison.
 func (identifier Identifier) IsDistinctFrom(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.IsDistinctFrom)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
 }
 
 // IsNotDistinctFrom performs an "is not distinct from" comparison.
 func (identifier Identifier) IsNotDistinctFrom(value interface{}) InfixExpression {
 	operator := NewComparisonOperator(types.IsNotDistinctFrom)
-	return NewInfixExpression(identifier, operator, NewExpression(value))
+	return NewInfixExpression(identifier, operator, identifier.operand(value))
+}
+
+// Extract returns the json field (or array element) of given key, using a "->" operator.
+func (identifier Identifier) Extract(key interface{}) Identifier {
+	return NewIdentifier(NewJSONExtract(identifier, types.JSONExtract, JSONKey(key)))
+}
+
+// ExtractText returns the json field (or array element) of given key as text, using a "->>" operator.
+func (identifier Identifier) ExtractText(key interface{}) Identifier {
+	return NewIdentifier(NewJSONExtract(identifier, types.JSONExtractText, JSONKey(key)))
+}
+
+// ExtractPath returns the json object at given path, using a "#>" operator.
+func (identifier Identifier) ExtractPath(path ...string) Identifier {
+	return NewIdentifier(NewJSONExtract(identifier, types.JSONExtractPath, NewValue(path)))
+}
+
+// ExtractPathText returns the json object at given path as text, using a "#>>" operator.
+func (identifier Identifier) ExtractPathText(path ...string) Identifier {
+	return NewIdentifier(NewJSONExtract(identifier, types.JSONExtractPathText, NewValue(path)))
+}
+
+// HasKey performs a "has key" json comparison.
+func (identifier Identifier) HasKey(key string) JSONExistence {
+	return NewJSONExistence(identifier, types.JSONHasKey, NewValue(key))
+}
+
+// HasAnyKeys performs a "has any keys" json comparison.
+func (identifier Identifier) HasAnyKeys(keys ...string) JSONExistence {
+	return NewJSONExistence(identifier, types.JSONHasAnyKeys, NewValue(keys))
+}
+
+// HasAllKeys performs a "has all keys" json comparison.
+func (identifier Identifier) HasAllKeys(keys ...string) JSONExistence {
+	return NewJSONExistence(identifier, types.JSONHasAllKeys, NewValue(keys))
+}
+
+// JSONPathExists performs a "jsonpath returns any item" comparison.
+func (identifier Identifier) JSONPathExists(path string) JSONExistence {
+	return NewJSONExistence(identifier, types.JSONPathExists, NewValue(path))
+}
+
+// JSONPathMatch performs a "jsonpath predicate" comparison.
+func (identifier Identifier) JSONPathMatch(path string) InfixExpression {
+	operator := NewComparisonOperator(types.JSONPathMatch)
+	return NewInfixExpression(identifier, operator, NewValue(path))
+}
+
+// Index returns the array element at given index, using a subscript.
+func (identifier Identifier) Index(index interface{}) Identifier {
+	return NewIdentifier(NewSubscript(identifier, SubscriptIndex(index)))
+}
+
+// Slice returns the array slice between given bounds, using a subscript.
+// A nil bound is omitted, to slice from the start or up to the end of the array.
+func (identifier Identifier) Slice(lower, upper interface{}) Identifier {
+	return NewIdentifier(NewSlice(identifier, SubscriptIndex(lower), SubscriptIndex(upper)))
+}
+
+// StrictlyLeftOf performs a "strictly left of" range comparison.
+func (identifier Identifier) StrictlyLeftOf(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.StrictlyLeft)
+	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+}
+
+// StrictlyRightOf performs a "strictly right of" range comparison.
+func (identifier Identifier) StrictlyRightOf(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.StrictlyRight)
+	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+}
+
+// DoesNotExtendRightOf performs a "does not extend to the right of" range comparison.
+func (identifier Identifier) DoesNotExtendRightOf(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.NotExtendRight)
+	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+}
+
+// DoesNotExtendLeftOf performs a "does not extend to the left of" range comparison.
+func (identifier Identifier) DoesNotExtendLeftOf(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.NotExtendLeft)
+	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+}
+
+// IsAdjacentTo performs an "is adjacent to" range comparison.
+func (identifier Identifier) IsAdjacentTo(value interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.Adjacent)
+	return NewInfixExpression(identifier, operator, NewWrapper(NewExpression(value)))
+}
+
+// HstoreValue returns the hstore value of given key, using a "->" operator.
+func (identifier Identifier) HstoreValue(key string) Identifier {
+	return NewIdentifier(NewJSONExtract(identifier, types.JSONExtract, NewValue(key)))
+}
+
+// HstoreHasKey performs a "has key" hstore comparison.
+func (identifier Identifier) HstoreHasKey(key string) JSONExistence {
+	return NewHstoreExistence(identifier, types.JSONHasKey, NewValue(key))
+}
+
+// HstoreHasAnyKeys performs a "has any keys" hstore comparison.
+func (identifier Identifier) HstoreHasAnyKeys(keys ...string) JSONExistence {
+	return NewHstoreExistence(identifier, types.JSONHasAnyKeys, NewValue(keys))
+}
+
+// HstoreHasAllKeys performs a "has all keys" hstore comparison.
+func (identifier Identifier) HstoreHasAllKeys(keys ...string) JSONExistence {
+	return NewHstoreExistence(identifier, types.JSONHasAllKeys, NewValue(keys))
+}
+
+// Match performs a "text search match" comparison.
+func (identifier Identifier) Match(query interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.TextSearchMatch)
+	return NewInfixExpression(identifier, operator, NewExpression(query))
 }
 
 // Ensure that Identifier is an Expression
@@ -224,15 +476,22 @@ var _ Expression = Identifier{}
 // ----------------------------------------------------------------------------
 
 // Value is an expression value.
+// It exposes the comparison methods of an Identifier, such as Equal or Between.
 type Value struct {
 	Value interface{}
+	comparison
 }
 
 // NewValue returns an expression value.
 func NewValue(value interface{}) Value {
-	return Value{
+	return withComparison(Value{
 		Value: value,
-	}
+	})
+}
+
+func (value Value) renewComparison() Expression {
+	value.comparison = NewIdentifier(Value{Value: value.Value})
+	return value
 }
 
 func (Value) expression() {}
@@ -246,36 +505,6 @@ func (value Value) Write(ctx types.Context) {
 	}
 }
 
-// Overlap performs an "overlap" comparison.
-func (value Value) Overlap(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Overlap)
-	return NewInfixExpression(value, operator, NewWrapper(NewExpression(what)))
-}
-
-// Equal performs an "equal" comparison.
-func (value Value) Equal(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Equal)
-	return NewInfixExpression(value, operator, NewWrapper(NewExpression(what)))
-}
-
-// NotEqual performs a "not equal" comparison.
-func (value Value) NotEqual(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.NotEqual)
-	return NewInfixExpression(value, operator, NewWrapper(NewExpression(what)))
-}
-
-// Contains performs a "contains" comparison.
-func (value Value) Contains(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Contains)
-	return NewInfixExpression(value, operator, NewWrapper(NewExpression(what)))
-}
-
-// IsContainedBy performs a "is contained by" comparison.
-func (value Value) IsContainedBy(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.IsContainedBy)
-	return NewInfixExpression(value, operator, NewWrapper(NewExpression(what)))
-}
-
 // IsEmpty returns true if statement is undefined.
 func (value Value) IsEmpty() bool {
 	return false
@@ -298,18 +527,48 @@ func NewArrayListExpression(values ...interface{}) Expression { // nolint: gocyc
 	// We pass only one argument and it's a slice or an expression.
 	arraylist := toArrayList(values[0])
 	if len(arraylist.Values) == 0 {
-		arraylist.Values = []Expression{NewArrayExpression(values...)}
+		arraylist.Values = []Expression{newArrayRow(values)}
 
 	}
 	return arraylist
 }
 
+// newArrayRow creates a row of an ArrayList, where scalar subqueries are enclosed in parenthesis.
+func newArrayRow(values []interface{}) Expression {
+	if len(values) == 1 {
+		return NewArrayExpression(values...)
+	}
+	array := Array{}
+	for i := range values {
+		array.Values = append(array.Values, NewWrapper(NewExpression(values[i])))
+	}
+	return array
+}
+
+// isArrayList returns true if given value is a list of rows supported by toArrayList, even an empty one.
+func isArrayList(value interface{}) bool {
+	switch value.(type) {
+	case []Row, [][]interface{}, [][]string, [][]int, [][]int64, [][]bool, [][]Expression:
+		return true
+	default:
+		return false
+	}
+}
+
 func toArrayList(value interface{}) ArrayList { // nolint: gocyclo
 	arraylist := ArrayList{}
 	switch values := value.(type) {
+	case []Row:
+		for i := range values {
+			row := Array{}
+			for j := range values[i].Values {
+				row.Values = append(row.Values, NewWrapper(values[i].Values[j]))
+			}
+			arraylist.Values = append(arraylist.Values, row)
+		}
 	case [][]interface{}:
 		for i := range values {
-			arraylist.Values = append(arraylist.Values, NewArrayExpression(values[i]...))
+			arraylist.Values = append(arraylist.Values, newArrayRow(values[i]))
 		}
 	case [][]string:
 		for i := range values {
@@ -357,6 +616,15 @@ func toArrayList(value interface{}) ArrayList { // nolint: gocyclo
 	return arraylist
 }
 
+// newInValues creates the list of values of an IN condition: either a list of rows, when a single
+// list of tuples is given, or a flat list of values.
+func newInValues(values []interface{}) Expression {
+	if len(values) == 1 && isArrayList(values[0]) {
+		return toArrayList(values[0])
+	}
+	return NewArrayExpression(values...)
+}
+
 func (ArrayList) expression() {}
 
 // Write exposes statement as a SQL query.
@@ -587,23 +855,107 @@ var _ Expression = Wrapper{}
 // ----------------------------------------------------------------------------
 
 // Call is a call expression.
+// It exposes the comparison methods of an Identifier, such as Equal or Between.
 type Call struct {
 	function string
 	args     []Expression
+	comparison
 }
 
+var functionPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)*$`)
+
 // NewCall returns a new Call.
+// Function name can be qualified with a schema, such as "public.my_function".
 func NewCall(function string, args ...Expression) Call {
-	return Call{
+	return withComparison(Call{
 		function: function,
 		args:     args,
-	}
+	})
 }
 
-func (Call) expression() {}
+// NewCoalesce returns a new Call of COALESCE, which returns its first non-null argument.
+func NewCoalesce(args ...Expression) Call {
+	return NewCall("COALESCE", args...)
+}
+
+// NewNullIf returns a new Call of NULLIF, which returns null if both arguments are equal.
+func NewNullIf(value Expression, other Expression) Call {
+	return NewCall("NULLIF", value, other)
+}
+
+// NewGreatest returns a new Call of GREATEST, which returns the largest of its arguments.
+func NewGreatest(args ...Expression) Call {
+	return NewCall("GREATEST", args...)
+}
+
+// NewLeast returns a new Call of LEAST, which returns the smallest of its arguments.
+func NewLeast(args ...Expression) Call {
+	return NewCall("LEAST", args...)
+}
+
+// Arg appends given argument to the call.
+func (call Call) Arg(value interface{}) Call {
+	return call.append(NewExpression(value))
+}
+
+// NamedArg appends given argument to the call using a named notation, such as "name => value".
+func (call Call) NamedArg(name string, value interface{}) Call {
+	return call.append(NewNamedArgument(name, NewExpression(value)))
+}
+
+// VariadicArg appends given array as the variadic argument of the call, such as "VARIADIC value".
+func (call Call) VariadicArg(value interface{}) Call {
+	return call.append(NewVariadicArgument(NewExpression(value)))
+}
+
+func (call Call) append(arg Expression) Call {
+	args := make([]Expression, len(call.args), len(call.args)+1)
+	copy(args, call.args)
+	call.args = append(args, arg)
+	return withComparison(call)
+}
+
+func (call Call) renewComparison() Expression {
+	call.comparison = NewIdentifier(Call{function: call.function, args: call.args})
+	return call
+}
+
+// Function returns the name of the called function.
+func (call Call) Function() string {
+	return call.function
+}
+
+// Args returns a copy of the call arguments.
+func (call Call) Args() []Expression {
+	args := make([]Expression, len(call.args))
+	copy(args, call.args)
+	return args
+}
+
+// As is used to give an alias name to the call.
+func (call Call) As(alias string) Alias {
+	return NewAlias(call, alias)
+}
+
+// Asc is used to transform a call to an order expression.
+func (call Call) Asc() Order {
+	return NewExpressionOrder(call, types.Asc)
+}
+
+// Desc is used to transform a call to an order expression.
+func (call Call) Desc() Order {
+	return NewExpressionOrder(call, types.Desc)
+}
+
+func (Call) expression()       {}
+func (Call) selectExpression() {}
 
 // Write writes call to ctx.
 func (call Call) Write(ctx types.Context) {
+	if !functionPattern.MatchString(call.function) {
+		panic("loukoum: invalid function name")
+	}
+
 	ctx.Write(call.function)
 	ctx.Write("(")
 	for i, arg := range call.args {
@@ -615,51 +967,65 @@ func (call Call) Write(ctx types.Context) {
 	ctx.Write(")")
 }
 
-// Equal adds an = expression.
-func (call Call) Equal(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Equal)
-	return NewInfixExpression(call, operator, NewWrapper(NewExpression(what)))
+// IsEmpty reports whether call is empty.
+func (call Call) IsEmpty() bool {
+	return call.function == ""
 }
 
-// GreaterThan performs a "greater than" comparison.
-func (call Call) GreaterThan(value interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.GreaterThan)
-	return NewInfixExpression(call, operator, NewWrapper(NewExpression(value)))
-}
+// Ensure that Call is an Expression
+var _ Expression = Call{}
 
-// LessThan performs a "less than" comparison.
-func (call Call) LessThan(value interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.LessThan)
-	return NewInfixExpression(call, operator, NewWrapper(NewExpression(value)))
-}
+// Ensure that Call is a SelectExpression
+var _ SelectExpression = Call{}
 
-// Like performs a "like" condition.
-func (call Call) Like(value interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Like)
-	return NewInfixExpression(call, operator, NewExpression(value))
+// Argument is an argument of a Call using either a named or a variadic notation.
+type Argument struct {
+	Name     string
+	Variadic bool
+	Value    Expression
 }
 
-// ILike performs a "ilike" condition.
-func (call Call) ILike(value interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.ILike)
-	return NewInfixExpression(call, operator, NewExpression(value))
+// NewNamedArgument returns a new Argument instance using a named notation.
+func NewNamedArgument(name string, value Expression) Argument {
+	return Argument{
+		Name:  name,
+		Value: value,
+	}
 }
 
-// In adds a IN expression.
-func (call Call) In(value ...interface{}) In {
-	return NewIn(call, NewArrayExpression(value...))
+// NewVariadicArgument returns a new Argument instance using a variadic notation.
+func NewVariadicArgument(value Expression) Argument {
+	return Argument{
+		Variadic: true,
+		Value:    value,
+	}
 }
 
-// Overlap performs an "overlap" comparison.
-func (call Call) Overlap(what interface{}) InfixExpression {
-	operator := NewComparisonOperator(types.Overlap)
-	return NewInfixExpression(call, operator, NewWrapper(NewExpression(what)))
+func (Argument) expression() {}
+
+// Write exposes statement as a SQL query.
+func (argument Argument) Write(ctx types.Context) {
+	if argument.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	if argument.Variadic {
+		ctx.Write("VARIADIC ")
+	}
+	if argument.Name != "" {
+		if !functionPattern.MatchString(argument.Name) || strings.Contains(argument.Name, ".") {
+			panic("loukoum: invalid argument name")
+		}
+		ctx.Write(argument.Name)
+		ctx.Write(" => ")
+	}
+	argument.Value.Write(ctx)
 }
 
-// IsEmpty reports whether call is empty.
-func (call Call) IsEmpty() bool {
-	return false
+// IsEmpty returns true if statement is undefined.
+func (argument Argument) IsEmpty() bool {
+	return argument.Value == nil || argument.Value.IsEmpty()
 }
 
-// Ensure that Value is an Expression
-var _ Expression = Call{}
+// Ensure that Argument is an Expression
+var _ Expression = Argument{}
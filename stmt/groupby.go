@@ -6,8 +6,10 @@ import (
 )
 
 // GroupBy is a GROUP BY clause.
+// Columns are written before Expressions.
 type GroupBy struct {
-	Columns []Column
+	Columns     []Column
+	Expressions []Expression
 }
 
 // NewGroupBy returns a new GroupBy instance.
@@ -17,6 +19,13 @@ func NewGroupBy(columns []Column) GroupBy {
 	}
 }
 
+// NewGroupByExpressions returns a new GroupBy instance using given expressions, such as function calls.
+func NewGroupByExpressions(expressions []Expression) GroupBy {
+	return GroupBy{
+		Expressions: expressions,
+	}
+}
+
 // Write exposes statement as a SQL query.
 func (group GroupBy) Write(ctx types.Context) {
 	ctx.Write(token.Group.String())
@@ -29,11 +38,17 @@ func (group GroupBy) Write(ctx types.Context) {
 		}
 		group.Columns[i].Write(ctx)
 	}
+	for i := range group.Expressions {
+		if i != 0 || len(group.Columns) != 0 {
+			ctx.Write(", ")
+		}
+		group.Expressions[i].Write(ctx)
+	}
 }
 
 // IsEmpty returns true if statement is undefined.
 func (group GroupBy) IsEmpty() bool {
-	return len(group.Columns) == 0
+	return len(group.Columns) == 0 && len(group.Expressions) == 0
 }
 
 // Ensure that GroupBy is a Statement
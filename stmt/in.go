@@ -37,14 +37,22 @@ func (in In) Write(ctx types.Context) {
 		panic("loukoum: expression is undefined")
 	}
 
+	// An empty list is a syntax error: IN is always false and NOT IN always true.
+	if in.Value.IsEmpty() {
+		if in.Operator.Operator == types.NotIn {
+			ctx.Write("TRUE")
+		} else {
+			ctx.Write("FALSE")
+		}
+		return
+	}
+
 	ctx.Write("(")
 	in.Expression.Write(ctx)
 	ctx.Write(" ")
 	in.Operator.Write(ctx)
 	ctx.Write(" (")
-	if !in.Value.IsEmpty() {
-		in.Value.Write(ctx)
-	}
+	in.Value.Write(ctx)
 	ctx.Write("))")
 }
 
@@ -58,3 +58,21 @@ func (expression InfixExpression) Or(value Expression) InfixExpression {
 
 // Ensure that InfixExpression is an Expression
 var _ Expression = InfixExpression{}
+
+// NewLogicalExpression joins given conditions using given logical operator.
+// Nil and empty conditions are skipped: without any remaining condition, the returned expression is empty,
+// and with a single one, it's returned as is.
+func NewLogicalExpression(operator types.LogicalOperator, conditions ...Expression) Expression {
+	var expression Expression = InfixExpression{}
+	for i := range conditions {
+		if conditions[i] == nil || conditions[i].IsEmpty() {
+			continue
+		}
+		if expression.IsEmpty() {
+			expression = conditions[i]
+			continue
+		}
+		expression = NewInfixExpression(expression, NewLogicalOperator(operator), NewWrapper(conditions[i]))
+	}
+	return expression
+}
@@ -7,9 +7,13 @@ import (
 
 // Insert is a INSERT statement.
 type Insert struct {
+	With       With
 	Into       Into
 	Columns    []Column
+	Overriding types.Overriding
 	Values     Values
+	Select     Select
+	Default    bool
 	OnConflict OnConflict
 	Returning  Returning
 	Comment    Comment
@@ -20,12 +24,19 @@ func NewInsert() Insert {
 	return Insert{}
 }
 
+func (Insert) expression() {}
+
 // Write exposes statement as a SQL query.
 func (insert Insert) Write(ctx types.Context) {
 	if insert.IsEmpty() {
 		panic("loukoum: an insert statement must have at least one column")
 	}
 
+	if !insert.With.IsEmpty() {
+		insert.With.Write(ctx)
+		ctx.Write(" ")
+	}
+
 	ctx.Write(token.Insert.String())
 	ctx.Write(" ")
 	insert.Into.Write(ctx)
@@ -41,14 +52,28 @@ func (insert Insert) Write(ctx types.Context) {
 		ctx.Write(")")
 	}
 
-	if !insert.Values.IsEmpty() {
+	if insert.Overriding != "" {
+		ctx.Write(" ")
+		ctx.Write(insert.Overriding.String())
+	}
+
+	switch {
+	case !insert.Values.IsEmpty():
 		ctx.Write(" ")
 		insert.Values.Write(ctx)
+	case !insert.Select.IsEmpty():
+		ctx.Write(" ")
+		insert.Select.Write(ctx)
+	case insert.Default:
+		ctx.Write(" ")
+		ctx.Write(token.Default.String())
+		ctx.Write(" ")
+		ctx.Write(token.Values.String())
 	}
 
 	if !insert.OnConflict.IsEmpty() {
 		ctx.Write(" ")
-		insert.OnConflict.Write(ctx)
+		insert.OnConflict.resolve(insert.Columns).Write(ctx)
 	}
 
 	if !insert.Returning.IsEmpty() {
@@ -68,5 +93,5 @@ func (insert Insert) IsEmpty() bool {
 	return insert.Into.IsEmpty()
 }
 
-// Ensure that Insert is a Statement
-var _ Statement = Insert{}
+// Ensure that Insert is an Expression
+var _ Expression = Insert{}
@@ -9,7 +9,9 @@ import (
 type Join struct {
 	Type      types.JoinType
 	Table     Table
+	Derived   DerivedTable
 	Condition Expression
+	Using     JoinUsing
 }
 
 // NewJoin returns a new Join instance.
@@ -36,21 +38,83 @@ func NewRightJoin(table Table, condition Expression) Join {
 	return NewJoin(types.RightJoin, table, condition)
 }
 
+// NewCrossJoin returns a new Join instance using a CROSS JOIN.
+func NewCrossJoin(table Table) Join {
+	return NewJoin(types.CrossJoin, table, nil)
+}
+
 // Write exposes statement as a SQL query.
 func (join Join) Write(ctx types.Context) {
+	if !join.Type.RequiresCondition() && (join.hasCondition() || !join.Using.IsEmpty()) {
+		panic("loukoum: cross and natural joins don't accept a join condition")
+	}
+
 	ctx.Write(join.Type.String())
 	ctx.Write(" ")
-	join.Table.Write(ctx)
-	ctx.Write(" ")
-	ctx.Write(token.On.String())
-	ctx.Write(" ")
-	join.Condition.Write(ctx)
+	if !join.Derived.IsEmpty() {
+		join.Derived.Write(ctx)
+	} else {
+		join.Table.Write(ctx)
+	}
+
+	if !join.Using.IsEmpty() {
+		ctx.Write(" ")
+		join.Using.Write(ctx)
+		return
+	}
+
+	if join.hasCondition() {
+		ctx.Write(" ")
+		ctx.Write(token.On.String())
+		ctx.Write(" ")
+		join.Condition.Write(ctx)
+	}
+}
+
+func (join Join) hasCondition() bool {
+	return join.Condition != nil && !join.Condition.IsEmpty()
 }
 
 // IsEmpty returns true if statement is undefined.
 func (join Join) IsEmpty() bool {
-	return join.Type == "" || join.Table.IsEmpty() || join.Condition.IsEmpty()
+	if join.Type == "" || (join.Table.IsEmpty() && join.Derived.IsEmpty()) {
+		return true
+	}
+	return join.Type.RequiresCondition() && !join.hasCondition() && join.Using.IsEmpty()
 }
 
 // Ensure that Join is a Statement
 var _ Statement = Join{}
+
+// JoinUsing is a USING clause of a join, listing the columns to match on both sides.
+type JoinUsing struct {
+	Columns []Column
+}
+
+// NewJoinUsing returns a new JoinUsing instance.
+func NewJoinUsing(columns []Column) JoinUsing {
+	return JoinUsing{
+		Columns: columns,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (using JoinUsing) Write(ctx types.Context) {
+	ctx.Write(token.Using.String())
+	ctx.Write(" (")
+	for i := range using.Columns {
+		if i != 0 {
+			ctx.Write(", ")
+		}
+		using.Columns[i].Write(ctx)
+	}
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (using JoinUsing) IsEmpty() bool {
+	return len(using.Columns) == 0
+}
+
+// Ensure that JoinUsing is a Statement
+var _ Statement = JoinUsing{}
@@ -0,0 +1,237 @@
+package stmt
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// ----------------------------------------------------------------------------
+// JSONExtract
+// ----------------------------------------------------------------------------
+
+// JSONExtract is an expression that extracts a field or a path from a json document.
+// For example, the expression "metadata ->> 'plan'" is a json extract expression.
+type JSONExtract struct {
+	Document Expression
+	Operator ComparisonOperator
+	Key      Expression
+}
+
+// NewJSONExtract returns a new JSONExtract instance.
+func NewJSONExtract(document Expression, operator types.ComparisonOperator, key Expression) JSONExtract {
+	return JSONExtract{
+		Document: document,
+		Operator: NewComparisonOperator(operator),
+		Key:      key,
+	}
+}
+
+func (JSONExtract) expression() {}
+
+// Write exposes statement as a SQL query.
+func (extract JSONExtract) Write(ctx types.Context) {
+	if extract.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	extract.Document.Write(ctx)
+	ctx.Write(" ")
+	extract.Operator.Write(ctx)
+	ctx.Write(" ")
+	extract.Key.Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (extract JSONExtract) IsEmpty() bool {
+	return extract.Document == nil || extract.Key == nil ||
+		extract.Document.IsEmpty() || extract.Operator.IsEmpty() || extract.Key.IsEmpty()
+}
+
+// Ensure that JSONExtract is an Expression
+var _ Expression = JSONExtract{}
+
+// JSONKey returns the expression of a json object key or a json array index.
+// Array indexes are written as is since a bound parameter would be resolved as a text key.
+func JSONKey(key interface{}) Expression {
+	switch value := key.(type) {
+	case int:
+		return NewRaw(strconv.FormatInt(int64(value), 10))
+	case int32:
+		return NewRaw(strconv.FormatInt(int64(value), 10))
+	case int64:
+		return NewRaw(strconv.FormatInt(value, 10))
+	default:
+		return NewExpression(value)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// JSONExistence
+// ----------------------------------------------------------------------------
+
+// JSONExistence is an expression using a jsonb operator that contains a question mark,
+// such as '?', '?|', '?&' or '@?'.
+//
+// Named queries are usually compiled to "?" markers before being sent to the database (sqlx does that,
+// for example), so the operator would be mistaken for a placeholder. On a named context, the expression
+// is written using its equivalent function instead.
+//
+// The same operators are used by hstore, with their own equivalent functions.
+type JSONExistence struct {
+	Document Expression
+	Operator ComparisonOperator
+	Value    Expression
+	Hstore   bool
+}
+
+// NewJSONExistence returns a new JSONExistence instance.
+func NewJSONExistence(document Expression, operator types.ComparisonOperator, value Expression) JSONExistence {
+	return JSONExistence{
+		Document: document,
+		Operator: NewComparisonOperator(operator),
+		Value:    value,
+	}
+}
+
+// NewHstoreExistence returns a new JSONExistence instance on a hstore document.
+func NewHstoreExistence(document Expression, operator types.ComparisonOperator, value Expression) JSONExistence {
+	existence := NewJSONExistence(document, operator, value)
+	existence.Hstore = true
+	return existence
+}
+
+var hstoreExistenceFunctions = map[types.ComparisonOperator]string{
+	types.JSONHasKey:     "exist",
+	types.JSONHasAnyKeys: "exists_any",
+	types.JSONHasAllKeys: "exists_all",
+}
+
+var jsonExistenceFunctions = map[types.ComparisonOperator]string{
+	types.JSONHasKey:     "jsonb_exists",
+	types.JSONHasAnyKeys: "jsonb_exists_any",
+	types.JSONHasAllKeys: "jsonb_exists_all",
+	types.JSONPathExists: "jsonb_path_exists",
+}
+
+func (JSONExistence) expression() {}
+
+// Write exposes statement as a SQL query.
+func (existence JSONExistence) Write(ctx types.Context) {
+	if existence.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	named := isNamedContext(ctx)
+	functions := jsonExistenceFunctions
+	if existence.Hstore {
+		functions = hstoreExistenceFunctions
+	}
+	function, ok := functions[existence.Operator.Operator]
+	if named && ok {
+		NewCall(function, existence.Document, existence.Value).Write(ctx)
+		return
+	}
+
+	ctx.Write("(")
+	existence.Document.Write(ctx)
+	ctx.Write(" ")
+	existence.Operator.Write(ctx)
+	ctx.Write(" ")
+	existence.Value.Write(ctx)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (existence JSONExistence) IsEmpty() bool {
+	return existence.Document == nil || existence.Value == nil ||
+		existence.Document.IsEmpty() || existence.Operator.IsEmpty() || existence.Value.IsEmpty()
+}
+
+// And creates a new InfixExpression using given Expression.
+func (existence JSONExistence) And(value Expression) InfixExpression {
+	operator := NewAndOperator()
+	return NewInfixExpression(existence, operator, value)
+}
+
+// Or creates a new InfixExpression using given Expression.
+func (existence JSONExistence) Or(value Expression) InfixExpression {
+	operator := NewOrOperator()
+	return NewInfixExpression(existence, operator, value)
+}
+
+// Ensure that JSONExistence is an Expression
+var _ Expression = JSONExistence{}
+
+// ----------------------------------------------------------------------------
+// JSONValue
+// ----------------------------------------------------------------------------
+
+// JSONValue is a value encoded as a json document and bound as a jsonb parameter.
+type JSONValue struct {
+	Value interface{}
+}
+
+// NewJSONValue returns a new JSONValue instance.
+func NewJSONValue(value interface{}) JSONValue {
+	return JSONValue{
+		Value: value,
+	}
+}
+
+// ToJSONExpression returns given value if it's already an Expression, or a JSONValue otherwise.
+func ToJSONExpression(value interface{}) Expression {
+	expression, ok := value.(Expression)
+	if ok {
+		return expression
+	}
+	return NewJSONValue(value)
+}
+
+func (JSONValue) expression() {}
+
+// Write exposes statement as a SQL query.
+func (value JSONValue) Write(ctx types.Context) {
+	document, err := json.Marshal(value.Value)
+	if err != nil {
+		panic("loukoum: cannot encode value as json")
+	}
+	NewCast(NewValue(string(document)), "jsonb").Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (JSONValue) IsEmpty() bool {
+	return false
+}
+
+// Ensure that JSONValue is an Expression
+var _ Expression = JSONValue{}
+
+// ----------------------------------------------------------------------------
+// Helpers
+// ----------------------------------------------------------------------------
+
+// NewJSONBSet returns a new Call of jsonb_set, which replaces the value located at given path.
+// The value is encoded as a json document unless it's already an Expression.
+func NewJSONBSet(target Expression, path []string, value interface{}, createMissing bool) Call {
+	args := []Expression{target, NewValue(path), ToJSONExpression(value)}
+	if !createMissing {
+		args = append(args, NewRaw("false"))
+	}
+	return NewCall("jsonb_set", args...)
+}
+
+// NewJSONBBuildObject returns a new Call of jsonb_build_object using given key/value pairs.
+// Keys are bound as text, values are encoded as json documents unless they are already an Expression.
+func NewJSONBBuildObject(pairs ...types.Pair) Call {
+	args := make([]Expression, 0, len(pairs)*2)
+	for i := range pairs {
+		key, ok := pairs[i].Key.(string)
+		if !ok {
+			panic("loukoum: jsonb_build_object keys must be strings")
+		}
+		args = append(args, NewCast(NewValue(key), "text"), ToJSONExpression(pairs[i].Value))
+	}
+	return NewCall("jsonb_build_object", args...)
+}
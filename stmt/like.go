@@ -0,0 +1,54 @@
+package stmt
+
+import (
+	"strings"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// likeEscaper escapes the wildcards of a LIKE pattern using the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the "%" and "_" wildcards, and the backslash escape character, of given string
+// so it's matched literally by a LIKE pattern.
+func EscapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// LikeEscape is a LIKE pattern with an ESCAPE clause, such as "$1 ESCAPE '!'".
+type LikeEscape struct {
+	Pattern Expression
+	Escape  string
+}
+
+// NewLikeEscape returns a new LikeEscape instance.
+func NewLikeEscape(pattern Expression, escape string) LikeEscape {
+	return LikeEscape{
+		Pattern: pattern,
+		Escape:  escape,
+	}
+}
+
+func (LikeEscape) expression() {}
+
+// Write exposes statement as a SQL query.
+func (like LikeEscape) Write(ctx types.Context) {
+	if like.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+	if len([]rune(like.Escape)) > 1 {
+		panic("loukoum: like escape must be a single character")
+	}
+
+	like.Pattern.Write(ctx)
+	ctx.Write(" ESCAPE ")
+	ctx.Write(escapeLiteral(like.Escape))
+}
+
+// IsEmpty returns true if statement is undefined.
+func (like LikeEscape) IsEmpty() bool {
+	return like.Pattern == nil || like.Pattern.IsEmpty()
+}
+
+// Ensure that LikeEscape is an Expression
+var _ Expression = LikeEscape{}
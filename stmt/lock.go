@@ -0,0 +1,59 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Lock is a LOCK TABLE statement.
+type Lock struct {
+	Tables []Table
+	Mode   types.LockMode
+	NoWait bool
+}
+
+// NewLock returns a new Lock instance.
+func NewLock(tables []Table) Lock {
+	return Lock{
+		Tables: tables,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (lock Lock) Write(ctx types.Context) {
+	if lock.IsEmpty() {
+		panic("loukoum: a lock statement must have at least one table")
+	}
+
+	ctx.Write(token.Lock.String())
+	ctx.Write(" TABLE ")
+	for i := range lock.Tables {
+		if i != 0 {
+			ctx.Write(", ")
+		}
+		lock.Tables[i].Write(ctx)
+	}
+
+	switch lock.Mode {
+	case "":
+	case types.AccessShareLock, types.RowShareLock, types.RowExclusiveLock, types.ShareUpdateExclusiveLock,
+		types.ShareLock, types.ShareRowExclusiveLock, types.ExclusiveLock, types.AccessExclusiveLock:
+		ctx.Write(" IN ")
+		ctx.Write(lock.Mode.String())
+		ctx.Write(" MODE")
+	default:
+		panic("loukoum: unknown lock mode")
+	}
+
+	if lock.NoWait {
+		ctx.Write(" NOWAIT")
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (lock Lock) IsEmpty() bool {
+	return len(lock.Tables) == 0
+}
+
+// Ensure that Lock is a Statement
+var _ Statement = Lock{}
@@ -0,0 +1,260 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Merge is a MERGE statement.
+type Merge struct {
+	With      With
+	Into      Table
+	Using     Statement
+	Condition Expression
+	Whens     []MergeWhen
+	Returning Returning
+	Comment   Comment
+}
+
+// NewMerge returns a new Merge instance.
+func NewMerge() Merge {
+	return Merge{}
+}
+
+func (Merge) expression() {}
+
+// Write exposes statement as a SQL query.
+func (merge Merge) Write(ctx types.Context) {
+	if merge.IsEmpty() {
+		panic("loukoum: a merge statement must have a target, a source, a join condition and a when clause")
+	}
+
+	if !merge.With.IsEmpty() {
+		merge.With.Write(ctx)
+		ctx.Write(" ")
+	}
+
+	ctx.Write(token.Merge.String())
+	ctx.Write(" ")
+	ctx.Write(token.Into.String())
+	ctx.Write(" ")
+	merge.Into.Write(ctx)
+
+	ctx.Write(" ")
+	ctx.Write(token.Using.String())
+	ctx.Write(" ")
+	merge.Using.Write(ctx)
+
+	ctx.Write(" ")
+	ctx.Write(token.On.String())
+	ctx.Write(" ")
+	merge.Condition.Write(ctx)
+
+	for i := range merge.Whens {
+		ctx.Write(" ")
+		merge.Whens[i].Write(ctx)
+	}
+
+	if !merge.Returning.IsEmpty() {
+		ctx.Write(" ")
+		merge.Returning.Write(ctx)
+	}
+
+	if !merge.Comment.IsEmpty() {
+		ctx.Write(token.Semicolon.String())
+		ctx.Write(" ")
+		merge.Comment.Write(ctx)
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (merge Merge) IsEmpty() bool {
+	return merge.Into.IsEmpty() || merge.Using == nil || merge.Using.IsEmpty() ||
+		merge.Condition == nil || merge.Condition.IsEmpty() || len(merge.Whens) == 0
+}
+
+// Ensure that Merge is an Expression
+var _ Expression = Merge{}
+
+// MergeWhen is a WHEN [NOT] MATCHED clause of a MERGE statement.
+type MergeWhen struct {
+	Matched   bool
+	Condition Expression
+	Action    MergeAction
+}
+
+// NewMergeWhen returns a new MergeWhen instance.
+func NewMergeWhen(matched bool, condition Expression, action MergeAction) MergeWhen {
+	return MergeWhen{
+		Matched:   matched,
+		Condition: condition,
+		Action:    action,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (when MergeWhen) Write(ctx types.Context) {
+	if when.IsEmpty() {
+		panic("loukoum: merge when clause requires an action")
+	}
+
+	if when.Matched {
+		ctx.Write("WHEN MATCHED")
+	} else {
+		ctx.Write("WHEN NOT MATCHED")
+	}
+
+	if when.Condition != nil && !when.Condition.IsEmpty() {
+		ctx.Write(" ")
+		ctx.Write(token.And.String())
+		ctx.Write(" ")
+		when.Condition.Write(ctx)
+	}
+
+	ctx.Write(" THEN ")
+	when.Action.Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (when MergeWhen) IsEmpty() bool {
+	return when.Action == nil || when.Action.IsEmpty()
+}
+
+// Ensure that MergeWhen is a Statement
+var _ Statement = MergeWhen{}
+
+// MergeAction is an action used by a WHEN clause of a MERGE statement.
+// It can be either UPDATE, DELETE, INSERT or DO NOTHING.
+type MergeAction interface {
+	Statement
+	mergeAction()
+}
+
+// MergeUpdateAction is an UPDATE action of a MERGE statement.
+type MergeUpdateAction struct {
+	Set Set
+}
+
+// NewMergeUpdateAction returns a new MergeUpdateAction instance.
+func NewMergeUpdateAction(set Set) MergeUpdateAction {
+	return MergeUpdateAction{
+		Set: set,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (action MergeUpdateAction) Write(ctx types.Context) {
+	ctx.Write(token.Update.String())
+	ctx.Write(" ")
+	action.Set.Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (action MergeUpdateAction) IsEmpty() bool {
+	return action.Set.IsEmpty()
+}
+
+func (MergeUpdateAction) mergeAction() {}
+
+// MergeDeleteAction is a DELETE action of a MERGE statement.
+type MergeDeleteAction struct{}
+
+// NewMergeDeleteAction returns a new MergeDeleteAction instance.
+func NewMergeDeleteAction() MergeDeleteAction {
+	return MergeDeleteAction{}
+}
+
+// Write exposes statement as a SQL query.
+func (MergeDeleteAction) Write(ctx types.Context) {
+	ctx.Write(token.Delete.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (MergeDeleteAction) IsEmpty() bool {
+	return false
+}
+
+func (MergeDeleteAction) mergeAction() {}
+
+// MergeInsertAction is an INSERT action of a MERGE statement.
+// If no values are defined, a DEFAULT VALUES clause is used.
+type MergeInsertAction struct {
+	Columns []Column
+	Values  Values
+}
+
+// NewMergeInsertAction returns a new MergeInsertAction instance.
+func NewMergeInsertAction(columns []Column, values Values) MergeInsertAction {
+	return MergeInsertAction{
+		Columns: columns,
+		Values:  values,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (action MergeInsertAction) Write(ctx types.Context) {
+	ctx.Write(token.Insert.String())
+
+	if action.Values.IsEmpty() {
+		ctx.Write(" ")
+		ctx.Write(token.Default.String())
+		ctx.Write(" ")
+		ctx.Write(token.Values.String())
+		return
+	}
+
+	if len(action.Columns) > 0 {
+		ctx.Write(" (")
+		for i := range action.Columns {
+			if i != 0 {
+				ctx.Write(", ")
+			}
+			action.Columns[i].Write(ctx)
+		}
+		ctx.Write(")")
+	}
+
+	ctx.Write(" ")
+	action.Values.Write(ctx)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (MergeInsertAction) IsEmpty() bool {
+	return false
+}
+
+func (MergeInsertAction) mergeAction() {}
+
+// MergeNoAction is a DO NOTHING action of a MERGE statement.
+type MergeNoAction struct{}
+
+// NewMergeNoAction returns a new MergeNoAction instance.
+func NewMergeNoAction() MergeNoAction {
+	return MergeNoAction{}
+}
+
+// Write exposes statement as a SQL query.
+func (MergeNoAction) Write(ctx types.Context) {
+	ctx.Write(token.Do.String())
+	ctx.Write(" ")
+	ctx.Write(token.Nothing.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (MergeNoAction) IsEmpty() bool {
+	return false
+}
+
+func (MergeNoAction) mergeAction() {}
+
+// Ensure that MergeUpdateAction is a MergeAction
+var _ MergeAction = MergeUpdateAction{}
+
+// Ensure that MergeDeleteAction is a MergeAction
+var _ MergeAction = MergeDeleteAction{}
+
+// Ensure that MergeInsertAction is a MergeAction
+var _ MergeAction = MergeInsertAction{}
+
+// Ensure that MergeNoAction is a MergeAction
+var _ MergeAction = MergeNoAction{}
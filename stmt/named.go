@@ -0,0 +1,64 @@
+package stmt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+var namedValuePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// NamedValue is a value bound to an explicit parameter name, which is reused wherever it appears.
+type NamedValue struct {
+	Name  string
+	Value interface{}
+}
+
+// NewNamedValue returns a value bound to given parameter name.
+// A name can't start with the prefix of generated names, "arg_", so it never depends on the clause order.
+// A slice is bound as a single array parameter, such as in "= ANY(:ids)".
+func NewNamedValue(name string, value interface{}) NamedValue {
+	if !namedValuePattern.MatchString(name) {
+		panic("loukoum: invalid name for named parameter")
+	}
+	if strings.HasPrefix(name, types.GeneratedNamePrefix) {
+		panic(fmt.Sprintf("loukoum: named parameter cannot use the reserved prefix %s", types.GeneratedNamePrefix))
+	}
+
+	expression, ok := NewArrayParameter(value).(Value)
+	if !ok {
+		panic("loukoum: named parameter requires a value")
+	}
+
+	return NamedValue{
+		Name:  name,
+		Value: expression.Value,
+	}
+}
+
+func (NamedValue) expression() {}
+
+// Write exposes statement as a SQL query.
+// A context which doesn't support named parameters binds the value as an anonymous one.
+func (value NamedValue) Write(ctx types.Context) {
+	if value.IsEmpty() {
+		panic("loukoum: named parameter is undefined")
+	}
+
+	binder, ok := ctx.(types.NamedBinder)
+	if !ok {
+		ctx.Bind(value.Value)
+		return
+	}
+	binder.BindNamed(value.Name, value.Value)
+}
+
+// IsEmpty returns true if statement is undefined.
+func (value NamedValue) IsEmpty() bool {
+	return value.Name == ""
+}
+
+// Ensure that NamedValue is an Expression
+var _ Expression = NamedValue{}
@@ -73,3 +73,30 @@ func (operator ComparisonOperator) IsEmpty() bool {
 
 // Ensure that ComparisonOperator is an Operator
 var _ Operator = ComparisonOperator{}
+
+// ArithmeticOperator are used to compute a value from two expressions using an arithmetic operator.
+type ArithmeticOperator struct {
+	Operator types.ArithmeticOperator
+}
+
+// NewArithmeticOperator returns a new ArithmeticOperator instance.
+func NewArithmeticOperator(operator types.ArithmeticOperator) ArithmeticOperator {
+	return ArithmeticOperator{
+		Operator: operator,
+	}
+}
+
+func (ArithmeticOperator) operator() {}
+
+// Write exposes statement as a SQL query.
+func (operator ArithmeticOperator) Write(ctx types.Context) {
+	ctx.Write(operator.Operator.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (operator ArithmeticOperator) IsEmpty() bool {
+	return operator.Operator == ""
+}
+
+// Ensure that ArithmeticOperator is an Operator
+var _ Operator = ArithmeticOperator{}
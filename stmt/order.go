@@ -7,6 +7,7 @@ import (
 // Order is an expression of a ORDER BY clause.
 type Order struct {
 	Expression string
+	Value      Expression
 	Type       types.OrderType
 }
 
@@ -18,18 +19,33 @@ func NewOrder(expression string, kind types.OrderType) Order {
 	}
 }
 
+// NewExpressionOrder returns a new Order instance using an Expression.
+func NewExpressionOrder(expression Expression, kind types.OrderType) Order {
+	return Order{
+		Value: expression,
+		Type:  kind,
+	}
+}
+
 // Write exposes statement as a SQL query.
 func (order Order) Write(ctx types.Context) {
 	if order.IsEmpty() {
 		return
 	}
-	ctx.Write(order.Expression)
+	if order.Value != nil {
+		order.Value.Write(ctx)
+	} else {
+		ctx.Write(order.Expression)
+	}
 	ctx.Write(" ")
 	ctx.Write(order.Type.String())
 }
 
 // IsEmpty returns true if statement is undefined.
 func (order Order) IsEmpty() bool {
+	if order.Value != nil {
+		return order.Value.IsEmpty()
+	}
 	return order.Expression == ""
 }
 
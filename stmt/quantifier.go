@@ -0,0 +1,73 @@
+package stmt
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lib/pq"
+
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Quantifier is an ANY or ALL expression, used on the right side of a comparison to compare a value
+// with every element of an array or every row of a subquery.
+type Quantifier struct {
+	Quantifier token.Type
+	Value      Expression
+}
+
+// NewAny returns a new Quantifier instance using ANY.
+func NewAny(value Expression) Quantifier {
+	return Quantifier{
+		Quantifier: token.Any,
+		Value:      value,
+	}
+}
+
+// NewAll returns a new Quantifier instance using ALL.
+func NewAll(value Expression) Quantifier {
+	return Quantifier{
+		Quantifier: token.All,
+		Value:      value,
+	}
+}
+
+func (Quantifier) expression() {}
+
+// Write exposes statement as a SQL query.
+func (quantifier Quantifier) Write(ctx types.Context) {
+	if quantifier.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write(quantifier.Quantifier.String())
+	ctx.Write("(")
+	quantifier.Value.Write(ctx)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (quantifier Quantifier) IsEmpty() bool {
+	return quantifier.Quantifier == "" || quantifier.Value == nil || quantifier.Value.IsEmpty()
+}
+
+// Ensure that Quantifier is an Expression
+var _ Expression = Quantifier{}
+
+// NewArrayParameter returns a new Expression binding given slice as a single array parameter, so the
+// query doesn't change with the length of the slice. Other values are handled by NewExpression.
+func NewArrayParameter(arg interface{}) Expression {
+	switch value := arg.(type) {
+	case nil, []byte, Expression, StatementEncoder, driver.Valuer,
+		pgtype.FlatArray[string], pgtype.FlatArray[int64]:
+		return NewExpression(value)
+	}
+
+	if reflect.TypeOf(arg).Kind() == reflect.Slice {
+		return NewValue(pq.Array(arg))
+	}
+
+	return NewExpression(arg)
+}
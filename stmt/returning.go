@@ -26,7 +26,7 @@ func (returning Returning) Write(ctx types.Context) {
 		if i > 0 {
 			ctx.Write(", ")
 		}
-		returning.Columns[i].Write(ctx)
+		writeProjection(ctx, returning.Columns[i])
 	}
 }
 
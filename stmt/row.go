@@ -0,0 +1,73 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Row is a row constructor, such as "(a, b)", used to compare several values at once.
+// It exposes the comparison methods of an Identifier, such as Equal or In, where a slice of values given as
+// right operand is converted to a Row.
+type Row struct {
+	Values []Expression
+	comparison
+}
+
+// NewRow returns a new Row instance.
+func NewRow(values ...Expression) Row {
+	return withComparison(Row{
+		Values: values,
+	})
+}
+
+// NewValueRow returns a new Row instance where every given value is bound as a parameter.
+func NewValueRow(values ...interface{}) Row {
+	expressions := make([]Expression, len(values))
+	for i := range values {
+		expressions[i] = NewExpression(values[i])
+	}
+	return NewRow(expressions...)
+}
+
+func (Row) expression() {}
+
+// Write exposes statement as a SQL query.
+func (row Row) Write(ctx types.Context) {
+	if row.IsEmpty() {
+		panic("loukoum: row is undefined")
+	}
+
+	// A single value between parenthesis is not a row constructor.
+	if len(row.Values) == 1 {
+		ctx.Write("ROW")
+	}
+
+	ctx.Write("(")
+	for i := range row.Values {
+		if i > 0 {
+			ctx.Write(", ")
+		}
+		NewWrapper(row.Values[i]).Write(ctx)
+	}
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (row Row) IsEmpty() bool {
+	return len(row.Values) == 0
+}
+
+func (row Row) renewComparison() Expression {
+	row.comparison = NewIdentifier(Row{Values: row.Values})
+	return row
+}
+
+// toRowOperand converts a slice of values to a Row, so it can be compared to another Row.
+func toRowOperand(value interface{}) interface{} {
+	if values, ok := value.([]interface{}); ok {
+		return NewValueRow(values...)
+	}
+	return value
+}
+
+// Ensure that Row is an Expression
+var _ Expression = Row{}
@@ -0,0 +1,285 @@
+package stmt
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/ulule/loukoum/v3/types"
+)
+
+var searchConfigPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// writeSearchConfig writes given text search configuration name as a literal.
+// Configuration names are not bound since expression indexes only match a constant configuration.
+func writeSearchConfig(ctx types.Context, config string) {
+	if !searchConfigPattern.MatchString(config) {
+		panic("loukoum: invalid text search configuration name")
+	}
+	ctx.Write("'")
+	ctx.Write(config)
+	ctx.Write("', ")
+}
+
+func toDocument(arg interface{}) Expression {
+	name, ok := arg.(string)
+	if ok {
+		return NewIdentifier(name)
+	}
+	return NewExpression(arg)
+}
+
+// ----------------------------------------------------------------------------
+// TSVector
+// ----------------------------------------------------------------------------
+
+// TSVector is a to_tsvector expression.
+type TSVector struct {
+	Config   string
+	Document Expression
+}
+
+// NewTSVector returns a new TSVector instance.
+// Document is either a column name or an Expression.
+func NewTSVector(config string, document interface{}) TSVector {
+	return TSVector{
+		Config:   config,
+		Document: toDocument(document),
+	}
+}
+
+func (TSVector) expression() {}
+
+// Write exposes statement as a SQL query.
+func (vector TSVector) Write(ctx types.Context) {
+	if vector.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write("to_tsvector(")
+	if vector.Config != "" {
+		writeSearchConfig(ctx, vector.Config)
+	}
+	vector.Document.Write(ctx)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (vector TSVector) IsEmpty() bool {
+	return vector.Document == nil || vector.Document.IsEmpty()
+}
+
+// Match performs a "text search match" comparison.
+func (vector TSVector) Match(query interface{}) InfixExpression {
+	operator := NewComparisonOperator(types.TextSearchMatch)
+	return NewInfixExpression(vector, operator, NewExpression(query))
+}
+
+// Ensure that TSVector is an Expression
+var _ Expression = TSVector{}
+
+// ----------------------------------------------------------------------------
+// TSQuery
+// ----------------------------------------------------------------------------
+
+// TSQuery functions.
+const (
+	// ToTSQuery parses a query using tsquery syntax.
+	ToTSQuery = "to_tsquery"
+	// PlainToTSQuery parses a query in plain text, combining words with an AND operator.
+	PlainToTSQuery = "plainto_tsquery"
+	// PhraseToTSQuery parses a query in plain text, combining words with a FOLLOWED BY operator.
+	PhraseToTSQuery = "phraseto_tsquery"
+	// WebSearchToTSQuery parses a query using a syntax similar to the one used by web search engines.
+	WebSearchToTSQuery = "websearch_to_tsquery"
+)
+
+// TSQuery is a tsquery expression, created from a bound query text.
+type TSQuery struct {
+	Function string
+	Config   string
+	Query    Expression
+}
+
+// NewTSQuery returns a new TSQuery instance using given function.
+func NewTSQuery(function string, config string, query interface{}) TSQuery {
+	return TSQuery{
+		Function: function,
+		Config:   config,
+		Query:    NewExpression(query),
+	}
+}
+
+func (TSQuery) expression() {}
+
+// Write exposes statement as a SQL query.
+func (query TSQuery) Write(ctx types.Context) {
+	if query.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write(query.Function)
+	ctx.Write("(")
+	if query.Config != "" {
+		writeSearchConfig(ctx, query.Config)
+	}
+	query.Query.Write(ctx)
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (query TSQuery) IsEmpty() bool {
+	return query.Function == "" || query.Query == nil || query.Query.IsEmpty()
+}
+
+// Ensure that TSQuery is an Expression
+var _ Expression = TSQuery{}
+
+// ----------------------------------------------------------------------------
+// TSRank
+// ----------------------------------------------------------------------------
+
+// TSRank is a ts_rank expression.
+type TSRank struct {
+	Vector        Expression
+	Query         Expression
+	Normalization int
+}
+
+// NewTSRank returns a new TSRank instance.
+// Vector is either a column name or an Expression.
+func NewTSRank(vector interface{}, query interface{}) TSRank {
+	return TSRank{
+		Vector: toDocument(vector),
+		Query:  NewExpression(query),
+	}
+}
+
+// As is used to give an alias name to the ts_rank function.
+func (rank TSRank) As(alias string) Alias {
+	return NewAlias(rank, alias)
+}
+
+// Normalize defines how document length should impact its rank.
+func (rank TSRank) Normalize(normalization int) TSRank {
+	rank.Normalization = normalization
+	return rank
+}
+
+// Asc is used to transform a ts_rank to an order expression.
+func (rank TSRank) Asc() Order {
+	return NewExpressionOrder(rank, types.Asc)
+}
+
+// Desc is used to transform a ts_rank to an order expression.
+func (rank TSRank) Desc() Order {
+	return NewExpressionOrder(rank, types.Desc)
+}
+
+func (TSRank) expression()       {}
+func (TSRank) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (rank TSRank) Write(ctx types.Context) {
+	if rank.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write("ts_rank(")
+	rank.Vector.Write(ctx)
+	ctx.Write(", ")
+	rank.Query.Write(ctx)
+	if rank.Normalization != 0 {
+		ctx.Write(", ")
+		ctx.Write(strconv.Itoa(rank.Normalization))
+	}
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (rank TSRank) IsEmpty() bool {
+	return rank.Vector == nil || rank.Query == nil || rank.Vector.IsEmpty() || rank.Query.IsEmpty()
+}
+
+// Ensure that TSRank is an Expression
+var _ Expression = TSRank{}
+
+// Ensure that TSRank is a SelectExpression
+var _ SelectExpression = TSRank{}
+
+// ----------------------------------------------------------------------------
+// TSHeadline
+// ----------------------------------------------------------------------------
+
+// TSHeadline is a ts_headline expression.
+type TSHeadline struct {
+	Config   string
+	Document Expression
+	Query    Expression
+	Options  string
+}
+
+// NewTSHeadline returns a new TSHeadline instance.
+// Document is either a column name or an Expression.
+func NewTSHeadline(config string, document interface{}, query interface{}) TSHeadline {
+	return TSHeadline{
+		Config:   config,
+		Document: toDocument(document),
+		Query:    NewExpression(query),
+	}
+}
+
+// As is used to give an alias name to the ts_headline function.
+func (headline TSHeadline) As(alias string) Alias {
+	return NewAlias(headline, alias)
+}
+
+// WithOptions defines ts_headline options, such as "MaxWords=35, MinWords=15".
+func (headline TSHeadline) WithOptions(options string) TSHeadline {
+	headline.Options = options
+	return headline
+}
+
+// Asc is used to transform a ts_headline to an order expression.
+func (headline TSHeadline) Asc() Order {
+	return NewExpressionOrder(headline, types.Asc)
+}
+
+// Desc is used to transform a ts_headline to an order expression.
+func (headline TSHeadline) Desc() Order {
+	return NewExpressionOrder(headline, types.Desc)
+}
+
+func (TSHeadline) expression()       {}
+func (TSHeadline) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (headline TSHeadline) Write(ctx types.Context) {
+	if headline.IsEmpty() {
+		panic("loukoum: expression is undefined")
+	}
+
+	ctx.Write("ts_headline(")
+	if headline.Config != "" {
+		writeSearchConfig(ctx, headline.Config)
+	}
+	headline.Document.Write(ctx)
+	ctx.Write(", ")
+	headline.Query.Write(ctx)
+	if headline.Options != "" {
+		ctx.Write(", ")
+		ctx.Bind(headline.Options)
+	}
+	ctx.Write(")")
+}
+
+// IsEmpty returns true if statement is undefined.
+func (headline TSHeadline) IsEmpty() bool {
+	return headline.Document == nil || headline.Query == nil ||
+		headline.Document.IsEmpty() || headline.Query.IsEmpty()
+}
+
+// Ensure that TSHeadline is an Expression
+var _ Expression = TSHeadline{}
+
+// Ensure that TSHeadline is a SelectExpression
+var _ SelectExpression = TSHeadline{}
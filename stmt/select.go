@@ -75,7 +75,7 @@ func (selekt Select) writeHead(ctx types.Context) {
 		} else {
 			ctx.Write(", ")
 		}
-		selekt.Expressions[i].Write(ctx)
+		writeProjection(ctx, selekt.Expressions[i])
 	}
 
 	if !selekt.From.IsEmpty() {
@@ -0,0 +1,165 @@
+package stmt
+
+import (
+	"regexp"
+
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+var parameterNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// SetParameter is a SET statement, which changes a run-time parameter for the session or, if local,
+// for the current transaction only.
+//
+// Since PostgreSQL doesn't accept bind parameters in a SET statement, the value is always embedded in
+// the query as an escaped literal.
+type SetParameter struct {
+	Name  string
+	Value Expression
+	Local bool
+}
+
+// NewSetParameter returns a new SetParameter instance.
+func NewSetParameter(name string, value Expression, local bool) SetParameter {
+	return SetParameter{
+		Name:  name,
+		Value: value,
+		Local: local,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (parameter SetParameter) Write(ctx types.Context) {
+	if parameter.IsEmpty() {
+		panic("loukoum: a set statement must have a name and a value")
+	}
+	if !parameterNamePattern.MatchString(parameter.Name) {
+		panic("loukoum: invalid parameter name for set statement")
+	}
+
+	ctx.Write(token.Set.String())
+	ctx.Write(" ")
+	if parameter.Local {
+		ctx.Write("LOCAL ")
+	}
+	ctx.Write(parameter.Name)
+	ctx.Write(" = ")
+
+	switch parameter.Value.(type) {
+	case Value, Default:
+		parameter.Value.Write(&literalContext{ctx})
+	default:
+		panic("loukoum: set statement only accepts a value or DEFAULT")
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (parameter SetParameter) IsEmpty() bool {
+	return parameter.Name == "" || parameter.Value == nil || parameter.Value.IsEmpty()
+}
+
+// Ensure that SetParameter is a Statement
+var _ Statement = SetParameter{}
+
+// Listen is a LISTEN statement.
+type Listen struct {
+	Channel string
+}
+
+// NewListen returns a new Listen instance.
+func NewListen(channel string) Listen {
+	return Listen{
+		Channel: channel,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (listen Listen) Write(ctx types.Context) {
+	if listen.IsEmpty() {
+		panic("loukoum: a listen statement must have a channel")
+	}
+
+	ctx.Write(token.Listen.String())
+	ctx.Write(" ")
+	ctx.Write(quoteIdentifier(listen.Channel))
+}
+
+// IsEmpty returns true if statement is undefined.
+func (listen Listen) IsEmpty() bool {
+	return listen.Channel == ""
+}
+
+// Ensure that Listen is a Statement
+var _ Statement = Listen{}
+
+// Unlisten is an UNLISTEN statement. Without channel, every registration is removed.
+type Unlisten struct {
+	Channel string
+}
+
+// NewUnlisten returns a new Unlisten instance.
+func NewUnlisten(channel string) Unlisten {
+	return Unlisten{
+		Channel: channel,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (unlisten Unlisten) Write(ctx types.Context) {
+	ctx.Write(token.Unlisten.String())
+	ctx.Write(" ")
+	if unlisten.Channel == "" {
+		ctx.Write(token.Asterisk.String())
+	} else {
+		ctx.Write(quoteIdentifier(unlisten.Channel))
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (Unlisten) IsEmpty() bool {
+	return false
+}
+
+// Ensure that Unlisten is a Statement
+var _ Statement = Unlisten{}
+
+// Notify is a NOTIFY statement.
+//
+// Since PostgreSQL doesn't accept bind parameters in a NOTIFY statement, the payload is embedded in
+// the query as an escaped literal. Use the pg_notify() function in a SELECT to bind it instead.
+type Notify struct {
+	Channel string
+	Payload string
+}
+
+// NewNotify returns a new Notify instance.
+func NewNotify(channel, payload string) Notify {
+	return Notify{
+		Channel: channel,
+		Payload: payload,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (notify Notify) Write(ctx types.Context) {
+	if notify.IsEmpty() {
+		panic("loukoum: a notify statement must have a channel")
+	}
+
+	ctx.Write(token.Notify.String())
+	ctx.Write(" ")
+	ctx.Write(quoteIdentifier(notify.Channel))
+	if notify.Payload != "" {
+		ctx.Write(", ")
+		ctx.Write(escapeLiteral(notify.Payload))
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (notify Notify) IsEmpty() bool {
+	return notify.Channel == ""
+}
+
+// Ensure that Notify is a Statement
+var _ Statement = Notify{}
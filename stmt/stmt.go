@@ -1,9 +1,14 @@
 package stmt
 
 import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ulule/loukoum/v3/format"
 	"github.com/ulule/loukoum/v3/types"
 )
 
@@ -24,3 +29,63 @@ func quote(ident string) string {
 	}
 	return strings.Join(quoted, ".")
 }
+
+// quoteIdentifier quotes given name as a single identifier, such as a channel or a savepoint.
+func quoteIdentifier(ident string) string {
+	if ident == "" || strings.ContainsRune(ident, 0) {
+		panic("loukoum: invalid identifier")
+	}
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+// escapeLiteral formats given string as an escape string constant, which is safe to embed in a query
+// regardless of the standard_conforming_strings setting.
+func escapeLiteral(value string) string {
+	return "E" + format.String(value)
+}
+
+// literalContext embeds bound values in the query as escaped literals, for utility statements
+// which don't support parameters.
+type literalContext struct {
+	types.Context
+}
+
+// Bind writes given value as an escaped literal.
+func (ctx *literalContext) Bind(value interface{}) {
+	if !isLiteral(value) {
+		panic(fmt.Sprintf("loukoum: cannot use %T as a literal", value))
+	}
+	literal := format.Value(value)
+	if strings.HasPrefix(literal, "'") {
+		literal = "E" + literal
+	}
+	ctx.Write(literal)
+}
+
+// isLiteral returns true if given value is formatted with an escaping encoder, so it can be embedded in a query.
+// It also applies to the value returned by a driver.Valuer, such as a pq array.
+func isLiteral(value interface{}) bool { // nolint: gocyclo
+	switch value := value.(type) {
+	case nil, string, []byte, time.Time, bool, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	case driver.Valuer:
+		reflectvalue := reflect.ValueOf(value)
+		if reflectvalue.Kind() == reflect.Ptr && reflectvalue.IsNil() {
+			return true
+		}
+		arg, err := value.Value()
+		return err == nil && isLiteral(arg)
+	default:
+		return false
+	}
+}
+
+// isNamedContext returns true if given context uses named query placeholders.
+func isNamedContext(ctx types.Context) bool {
+	if wrapper, ok := ctx.(*literalContext); ok {
+		ctx = wrapper.Context
+	}
+	_, ok := ctx.(*types.NamedContext)
+	return ok
+}
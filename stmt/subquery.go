@@ -70,3 +70,52 @@ func (NotExists) selectExpression() {}
 
 // Ensure that NotExists is an Expression
 var _ Expression = NotExists{}
+
+// Subquery is a scalar subquery expression.
+// It exposes the comparison methods of an Identifier, such as Equal or Between.
+type Subquery struct {
+	Query Expression
+	comparison
+}
+
+// NewSubquery returns a new Subquery instance.
+func NewSubquery(value interface{}) Subquery {
+	return withComparison(Subquery{
+		Query: NewExpression(value),
+	})
+}
+
+func (subquery Subquery) renewComparison() Expression {
+	subquery.comparison = NewIdentifier(Subquery{Query: subquery.Query})
+	return subquery
+}
+
+// As is used to give an alias name to the subquery.
+func (subquery Subquery) As(alias string) Alias {
+	return NewAlias(subquery, alias)
+}
+
+func (Subquery) expression()       {}
+func (Subquery) selectExpression() {}
+
+// Write exposes statement as a SQL query.
+func (subquery Subquery) Write(ctx types.Context) {
+	if subquery.IsEmpty() {
+		panic("loukoum: subquery is undefined")
+	}
+
+	ctx.Write(token.LParen.String())
+	subquery.Query.Write(ctx)
+	ctx.Write(token.RParen.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (subquery Subquery) IsEmpty() bool {
+	return subquery.Query == nil || subquery.Query.IsEmpty()
+}
+
+// Ensure that Subquery is an Expression
+var _ Expression = Subquery{}
+
+// Ensure that Subquery is a SelectExpression
+var _ SelectExpression = Subquery{}
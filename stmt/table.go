@@ -23,6 +23,11 @@ func (table Table) Only() Table {
 	return table
 }
 
+// IsOnly returns true if the table is used with an ONLY clause, which excludes its descendant tables.
+func (table Table) IsOnly() bool {
+	return table.only
+}
+
 // NewTableAlias returns a new Table instance with an alias.
 func NewTableAlias(name, alias string) Table {
 	return Table{
@@ -0,0 +1,177 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Begin is a BEGIN statement.
+type Begin struct {
+	IsolationLevel types.IsolationLevel
+	AccessMode     types.AccessMode
+	Deferrable     bool
+}
+
+// NewBegin returns a new Begin instance.
+func NewBegin() Begin {
+	return Begin{}
+}
+
+// Write exposes statement as a SQL query.
+func (begin Begin) Write(ctx types.Context) {
+	ctx.Write(token.Begin.String())
+
+	switch begin.IsolationLevel {
+	case "":
+	case types.ReadUncommitted, types.ReadCommitted, types.RepeatableRead, types.Serializable:
+		ctx.Write(" ISOLATION LEVEL ")
+		ctx.Write(begin.IsolationLevel.String())
+	default:
+		panic("loukoum: unknown isolation level")
+	}
+
+	switch begin.AccessMode {
+	case "":
+	case types.ReadWrite, types.ReadOnly:
+		ctx.Write(" ")
+		ctx.Write(begin.AccessMode.String())
+	default:
+		panic("loukoum: unknown access mode")
+	}
+
+	if begin.Deferrable {
+		ctx.Write(" DEFERRABLE")
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (Begin) IsEmpty() bool {
+	return false
+}
+
+// Ensure that Begin is a Statement
+var _ Statement = Begin{}
+
+// Commit is a COMMIT statement.
+type Commit struct{}
+
+// NewCommit returns a new Commit instance.
+func NewCommit() Commit {
+	return Commit{}
+}
+
+// Write exposes statement as a SQL query.
+func (Commit) Write(ctx types.Context) {
+	ctx.Write(token.Commit.String())
+}
+
+// IsEmpty returns true if statement is undefined.
+func (Commit) IsEmpty() bool {
+	return false
+}
+
+// Ensure that Commit is a Statement
+var _ Statement = Commit{}
+
+// Rollback is a ROLLBACK statement, which rolls back either the whole transaction or to given
+// savepoint.
+type Rollback struct {
+	Savepoint string
+}
+
+// NewRollback returns a new Rollback instance.
+func NewRollback() Rollback {
+	return Rollback{}
+}
+
+// NewRollbackTo returns a new Rollback instance to given savepoint.
+func NewRollbackTo(savepoint string) Rollback {
+	return Rollback{
+		Savepoint: savepoint,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (rollback Rollback) Write(ctx types.Context) {
+	ctx.Write(token.Rollback.String())
+	if rollback.Savepoint != "" {
+		ctx.Write(" ")
+		ctx.Write(token.To.String())
+		ctx.Write(" ")
+		ctx.Write(token.Savepoint.String())
+		ctx.Write(" ")
+		ctx.Write(quoteIdentifier(rollback.Savepoint))
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (Rollback) IsEmpty() bool {
+	return false
+}
+
+// Ensure that Rollback is a Statement
+var _ Statement = Rollback{}
+
+// Savepoint is a SAVEPOINT statement.
+type Savepoint struct {
+	Name string
+}
+
+// NewSavepoint returns a new Savepoint instance.
+func NewSavepoint(name string) Savepoint {
+	return Savepoint{
+		Name: name,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (savepoint Savepoint) Write(ctx types.Context) {
+	if savepoint.IsEmpty() {
+		panic("loukoum: a savepoint statement must have a name")
+	}
+
+	ctx.Write(token.Savepoint.String())
+	ctx.Write(" ")
+	ctx.Write(quoteIdentifier(savepoint.Name))
+}
+
+// IsEmpty returns true if statement is undefined.
+func (savepoint Savepoint) IsEmpty() bool {
+	return savepoint.Name == ""
+}
+
+// Ensure that Savepoint is a Statement
+var _ Statement = Savepoint{}
+
+// Release is a RELEASE SAVEPOINT statement.
+type Release struct {
+	Savepoint string
+}
+
+// NewRelease returns a new Release instance.
+func NewRelease(savepoint string) Release {
+	return Release{
+		Savepoint: savepoint,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (release Release) Write(ctx types.Context) {
+	if release.IsEmpty() {
+		panic("loukoum: a release statement must have a savepoint")
+	}
+
+	ctx.Write(token.Release.String())
+	ctx.Write(" ")
+	ctx.Write(token.Savepoint.String())
+	ctx.Write(" ")
+	ctx.Write(quoteIdentifier(release.Savepoint))
+}
+
+// IsEmpty returns true if statement is undefined.
+func (release Release) IsEmpty() bool {
+	return release.Savepoint == ""
+}
+
+// Ensure that Release is a Statement
+var _ Statement = Release{}
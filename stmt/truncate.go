@@ -0,0 +1,51 @@
+package stmt
+
+import (
+	"github.com/ulule/loukoum/v3/token"
+	"github.com/ulule/loukoum/v3/types"
+)
+
+// Truncate is a TRUNCATE statement.
+type Truncate struct {
+	Tables          []Table
+	RestartIdentity bool
+	Cascade         bool
+}
+
+// NewTruncate returns a new Truncate instance.
+func NewTruncate(tables []Table) Truncate {
+	return Truncate{
+		Tables: tables,
+	}
+}
+
+// Write exposes statement as a SQL query.
+func (truncate Truncate) Write(ctx types.Context) {
+	if truncate.IsEmpty() {
+		panic("loukoum: a truncate statement must have at least one table")
+	}
+
+	ctx.Write(token.Truncate.String())
+	ctx.Write(" ")
+	for i := range truncate.Tables {
+		if i != 0 {
+			ctx.Write(", ")
+		}
+		truncate.Tables[i].Write(ctx)
+	}
+
+	if truncate.RestartIdentity {
+		ctx.Write(" RESTART IDENTITY")
+	}
+	if truncate.Cascade {
+		ctx.Write(" CASCADE")
+	}
+}
+
+// IsEmpty returns true if statement is undefined.
+func (truncate Truncate) IsEmpty() bool {
+	return len(truncate.Tables) == 0
+}
+
+// Ensure that Truncate is a Statement
+var _ Statement = Truncate{}
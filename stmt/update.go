@@ -25,6 +25,8 @@ func NewUpdate(table Table) Update {
 	}
 }
 
+func (Update) expression() {}
+
 // Write exposes statement as a SQL query.
 func (update Update) Write(ctx types.Context) {
 	if update.IsEmpty() {
@@ -76,5 +78,5 @@ func (update Update) IsEmpty() bool {
 	return update.Table.IsEmpty() || update.Set.IsEmpty()
 }
 
-// Ensure that Update is a Statement
-var _ Statement = Update{}
+// Ensure that Update is an Expression
+var _ Expression = Update{}
@@ -7,11 +7,11 @@ import (
 
 // Using is a USING clause.
 type Using struct {
-	Tables []Table
+	Tables []Statement
 }
 
 // NewUsing returns a new Using instance.
-func NewUsing(tables []Table) Using {
+func NewUsing(tables []Statement) Using {
 	return Using{
 		Tables: tables,
 	}
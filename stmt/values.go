@@ -6,6 +6,7 @@ import (
 )
 
 // Values is a VALUES clause.
+// It can also be used as an expression, to provide a list of rows to a derived table.
 type Values struct {
 	Values Expression
 }
@@ -17,6 +18,8 @@ func NewValues(values Expression) Values {
 	}
 }
 
+func (Values) expression() {}
+
 // Write exposes statement as a SQL query.
 func (values Values) Write(ctx types.Context) {
 	if values.IsEmpty() {
@@ -33,5 +36,5 @@ func (values Values) IsEmpty() bool {
 	return values.Values == nil || (values.Values != nil && values.Values.IsEmpty())
 }
 
-// Ensure that Values is a Statement
-var _ Statement = Values{}
+// Ensure that Values is an Expression
+var _ Expression = Values{}
@@ -34,9 +34,13 @@ func (where Where) IsEmpty() bool {
 }
 
 // And appends given Expression using AND as logical operator.
+// An empty Expression is ignored, and an empty clause is replaced by given Expression.
 func (where Where) And(right Expression) Where {
+	if right == nil || right.IsEmpty() {
+		return where
+	}
 	if where.IsEmpty() {
-		panic("loukoum: two conditions are required for AND statement")
+		return NewWhere(right)
 	}
 
 	left := where.Condition
@@ -46,9 +50,13 @@ func (where Where) And(right Expression) Where {
 }
 
 // Or appends given Expression using OR as logical operator.
+// An empty Expression is ignored, and an empty clause is replaced by given Expression.
 func (where Where) Or(right Expression) Where {
+	if right == nil || right.IsEmpty() {
+		return where
+	}
 	if where.IsEmpty() {
-		panic("loukoum: two conditions are required for OR statement")
+		return NewWhere(right)
 	}
 
 	left := where.Condition
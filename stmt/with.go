@@ -24,6 +24,9 @@ func (with With) Write(ctx types.Context) {
 	}
 	ctx.Write(token.With.String())
 	ctx.Write(" ")
+	if with.IsRecursive() {
+		ctx.Write("RECURSIVE ")
+	}
 	for i := range with.Queries {
 		if i != 0 {
 			ctx.Write(", ")
@@ -37,10 +40,24 @@ func (with With) IsEmpty() bool {
 	return len(with.Queries) == 0
 }
 
+// IsRecursive returns true if one of the queries is recursive.
+// Since RECURSIVE is defined for the whole clause, every query may then reference itself.
+func (with With) IsRecursive() bool {
+	for i := range with.Queries {
+		if with.Queries[i].IsRecursive {
+			return true
+		}
+	}
+	return false
+}
+
 // WithQuery is a statement in a With clause.
 type WithQuery struct {
-	Name     string
-	Subquery Expression
+	Name            string
+	Columns         []string
+	Subquery        Expression
+	IsRecursive     bool
+	Materialization string
 }
 
 // Write exposes statement as a SQL query.
@@ -49,8 +66,22 @@ func (with WithQuery) Write(ctx types.Context) {
 		return
 	}
 	ctx.Write(with.Name)
+	if len(with.Columns) > 0 {
+		ctx.Write("(")
+		for i := range with.Columns {
+			if i != 0 {
+				ctx.Write(", ")
+			}
+			ctx.Write(quote(with.Columns[i]))
+		}
+		ctx.Write(")")
+	}
 	ctx.Write(" ")
 	ctx.Write(token.As.String())
+	if with.Materialization != "" {
+		ctx.Write(" ")
+		ctx.Write(with.Materialization)
+	}
 	ctx.Write(" (")
 	with.Subquery.Write(ctx)
 	ctx.Write(")")
@@ -61,7 +92,33 @@ func (with WithQuery) IsEmpty() bool {
 	return with.Name == "" || with.Subquery == nil || (with.Subquery != nil && with.Subquery.IsEmpty())
 }
 
+// WithColumns defines the column names of the query result.
+func (with WithQuery) WithColumns(columns ...string) WithQuery {
+	with.Columns = columns
+	return with
+}
+
+// Recursive allows the query to reference its own output, using a RECURSIVE clause.
+func (with WithQuery) Recursive() WithQuery {
+	with.IsRecursive = true
+	return with
+}
+
+// Materialized forces the query to be computed once, using a MATERIALIZED hint.
+func (with WithQuery) Materialized() WithQuery {
+	with.Materialization = "MATERIALIZED"
+	return with
+}
+
+// NotMaterialized allows the query to be folded into the parent query, using a NOT MATERIALIZED hint.
+func (with WithQuery) NotMaterialized() WithQuery {
+	with.Materialization = "NOT MATERIALIZED"
+	return with
+}
+
 // NewWithQuery returns a new WithQuery instance.
+// Value is either a query or a data-modifying statement, such as an INSERT, an UPDATE or a DELETE with a
+// RETURNING clause.
 func NewWithQuery(name string, value interface{}) WithQuery {
 	return WithQuery{
 		Name:     name,
@@ -39,6 +39,19 @@ const (
 const (
 	Select     = Type("SELECT")
 	Update     = Type("UPDATE")
+	Merge      = Type("MERGE")
+	Copy       = Type("COPY")
+	Explain    = Type("EXPLAIN")
+	Begin      = Type("BEGIN")
+	Commit     = Type("COMMIT")
+	Rollback   = Type("ROLLBACK")
+	Savepoint  = Type("SAVEPOINT")
+	Release    = Type("RELEASE")
+	Lock       = Type("LOCK")
+	Truncate   = Type("TRUNCATE")
+	Listen     = Type("LISTEN")
+	Unlisten   = Type("UNLISTEN")
+	Notify     = Type("NOTIFY")
 	Insert     = Type("INSERT")
 	Delete     = Type("DELETE")
 	From       = Type("FROM")
@@ -54,6 +67,7 @@ const (
 	Left       = Type("LEFT")
 	Right      = Type("RIGHT")
 	Join       = Type("JOIN")
+	Lateral    = Type("LATERAL")
 	On         = Type("ON")
 	Group      = Type("GROUP")
 	By         = Type("BY")
@@ -65,13 +79,22 @@ const (
 	Using      = Type("USING")
 	Returning  = Type("RETURNING")
 	Values     = Type("VALUES")
+	Default    = Type("DEFAULT")
 	Into       = Type("INTO")
+	To         = Type("TO")
+	Stdin      = Type("STDIN")
+	Stdout     = Type("STDOUT")
 	Conflict   = Type("CONFLICT")
 	Do         = Type("DO")
 	Nothing    = Type("NOTHING")
 	With       = Type("WITH")
 	Not        = Type("NOT")
 	Exists     = Type("EXISTS")
+	Any        = Type("ANY")
+	All        = Type("ALL")
+	True       = Type("TRUE")
+	False      = Type("FALSE")
+	Unknown    = Type("UNKNOWN")
 	Count      = Type("COUNT")
 	Max        = Type("MAX")
 	Min        = Type("MIN")
@@ -91,6 +114,9 @@ func (t *Token) String() string {
 var keywords = map[string]Type{
 	"SELECT":      Select,
 	"UPDATE":      Update,
+	"MERGE":       Merge,
+	"COPY":        Copy,
+	"EXPLAIN":     Explain,
 	"INSERT":      Insert,
 	"DELETE":      Delete,
 	"FROM":        From,
@@ -106,6 +132,7 @@ var keywords = map[string]Type{
 	"LEFT":        Left,
 	"RIGHT":       Right,
 	"JOIN":        Join,
+	"LATERAL":     Lateral,
 	"ON":          On,
 	"GROUP":       Group,
 	"BY":          By,
@@ -117,7 +144,11 @@ var keywords = map[string]Type{
 	"USING":       Using,
 	"RETURNING":   Returning,
 	"VALUES":      Values,
+	"DEFAULT":     Default,
 	"INTO":        Into,
+	"TO":          To,
+	"STDIN":       Stdin,
+	"STDOUT":      Stdout,
 	"CONFLICT":    Conflict,
 	"DO":          Do,
 	"NOTHING":     Nothing,
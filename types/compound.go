@@ -0,0 +1,24 @@
+package types
+
+// CompoundOperator represents an operator combining the results of two queries.
+type CompoundOperator string
+
+func (e CompoundOperator) String() string {
+	return string(e)
+}
+
+// Compound operators.
+const (
+	// Union has a "UNION" operator.
+	Union = CompoundOperator("UNION")
+	// UnionAll has a "UNION ALL" operator.
+	UnionAll = CompoundOperator("UNION ALL")
+	// Intersect has a "INTERSECT" operator.
+	Intersect = CompoundOperator("INTERSECT")
+	// IntersectAll has a "INTERSECT ALL" operator.
+	IntersectAll = CompoundOperator("INTERSECT ALL")
+	// Except has a "EXCEPT" operator.
+	Except = CompoundOperator("EXCEPT")
+	// ExceptAll has a "EXCEPT ALL" operator.
+	ExceptAll = CompoundOperator("EXCEPT ALL")
+)
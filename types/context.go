@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/ulule/loukoum/v3/format"
@@ -36,10 +37,39 @@ func (ctx *RawContext) Query() string {
 	return ctx.buffer.String()
 }
 
+// A NamedBinder is a Context which binds values to explicit parameter names.
+type NamedBinder interface {
+	BindNamed(name string, value interface{})
+}
+
+// NamedPrefix is the prefix of named query placeholders.
+type NamedPrefix string
+
+func (e NamedPrefix) String() string {
+	return string(e)
+}
+
+// Named placeholder prefixes.
+const (
+	// ColonPrefix writes placeholders as ":name".
+	ColonPrefix = NamedPrefix(":")
+	// AtPrefix writes placeholders as "@name".
+	AtPrefix = NamedPrefix("@")
+	// DollarPrefix writes placeholders as "$name".
+	DollarPrefix = NamedPrefix("$")
+)
+
+// GeneratedNamePrefix is the prefix of the names generated for anonymous parameters by a NamedContext,
+// such as "arg_1". It's reserved, so an explicit parameter name can't use it.
+const GeneratedNamePrefix = "arg_"
+
 // NamedContext uses named query placeholders.
 type NamedContext struct {
 	RawContext
+	// Prefix is the prefix of placeholders, ColonPrefix by default.
+	Prefix NamedPrefix
 	values map[string]interface{}
+	count  int
 }
 
 // Bind adds given value in context's values.
@@ -47,10 +77,42 @@ func (ctx *NamedContext) Bind(value interface{}) {
 	if ctx.values == nil {
 		ctx.values = make(map[string]interface{})
 	}
-	idx := len(ctx.values) + 1
-	name := fmt.Sprintf("arg_%d", idx)
+
+	ctx.count++
+	name := fmt.Sprintf("%s%d", GeneratedNamePrefix, ctx.count)
+
+	ctx.values[name] = value
+	ctx.writePlaceholder(name)
+}
+
+// BindNamed adds given value in context's values using given name.
+// A name may be bound several times, but always to the same value.
+func (ctx *NamedContext) BindNamed(name string, value interface{}) {
+	if strings.HasPrefix(name, GeneratedNamePrefix) {
+		panic(fmt.Sprintf("loukoum: named parameter %s uses the reserved prefix %s", name, GeneratedNamePrefix))
+	}
+	if ctx.values == nil {
+		ctx.values = make(map[string]interface{})
+	}
+
+	previous, ok := ctx.values[name]
+	if ok && !reflect.DeepEqual(previous, value) {
+		panic(fmt.Sprintf("loukoum: named parameter %s is bound to conflicting values", name))
+	}
+
 	ctx.values[name] = value
-	ctx.Write(":" + name)
+	ctx.writePlaceholder(name)
+}
+
+func (ctx *NamedContext) writePlaceholder(name string) {
+	switch ctx.Prefix {
+	case "":
+		ctx.Write(ColonPrefix.String() + name)
+	case ColonPrefix, AtPrefix, DollarPrefix:
+		ctx.Write(ctx.Prefix.String() + name)
+	default:
+		panic(fmt.Sprintf("loukoum: invalid prefix %s for named parameters", ctx.Prefix))
+	}
 }
 
 // Values returns the named argument values.
@@ -61,13 +123,106 @@ func (ctx *NamedContext) Values() map[string]interface{} {
 // StdContext uses positional query placeholders.
 type StdContext struct {
 	RawContext
-	values []interface{}
+	// Deduplicate reuses the placeholder of an identical value which is already bound.
+	// Since PostgreSQL infers a single type for each placeholder, a query where an identical value is compared
+	// to columns of different types, such as an integer and a text, fails with "inconsistent types deduced
+	// for parameter" once it's deduplicated.
+	Deduplicate bool
+	values      []interface{}
+	named       map[string]int
+	// positions and others index the bound values for deduplication: values of a basic type are looked up
+	// in a map, and the positions of other values, such as slices, are scanned.
+	positions map[interface{}]int
+	others    []int
 }
 
 // Bind adds given value in context's values.
 func (ctx *StdContext) Bind(value interface{}) {
-	idx := len(ctx.values) + 1
+	if ctx.Deduplicate {
+		idx, ok := ctx.lookup(value)
+		if ok {
+			ctx.writePlaceholder(idx)
+			return
+		}
+	}
+
+	ctx.append(value)
+	ctx.writePlaceholder(len(ctx.values))
+}
+
+// lookup returns the position of a value identical to given one, if it's already bound.
+func (ctx *StdContext) lookup(value interface{}) (int, bool) {
+	if isBasic(value) {
+		idx, ok := ctx.positions[value]
+		return idx, ok
+	}
+
+	for _, idx := range ctx.others {
+		if reflect.DeepEqual(ctx.values[idx-1], value) {
+			return idx, true
+		}
+	}
+	return 0, false
+}
+
+func (ctx *StdContext) append(value interface{}) {
 	ctx.values = append(ctx.values, value)
+	if !ctx.Deduplicate {
+		return
+	}
+
+	idx := len(ctx.values)
+	if !isBasic(value) {
+		ctx.others = append(ctx.others, idx)
+		return
+	}
+	if ctx.positions == nil {
+		ctx.positions = make(map[interface{}]int)
+	}
+	if _, ok := ctx.positions[value]; !ok {
+		ctx.positions[value] = idx
+	}
+}
+
+// isBasic returns true if given value uses a basic type, whose equality is the same with a map key and with
+// reflect.DeepEqual. A struct, such as a time.Time, may hold a pointer or a slice, so it isn't.
+func isBasic(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	default:
+		return false
+	}
+}
+
+// BindNamed adds given value in context's values, and reuses its position wherever given name appears.
+// A name may be bound several times, but always to the same value.
+func (ctx *StdContext) BindNamed(name string, value interface{}) {
+	if ctx.named == nil {
+		ctx.named = make(map[string]int)
+	}
+
+	idx, ok := ctx.named[name]
+	if ok {
+		if !reflect.DeepEqual(ctx.values[idx-1], value) {
+			panic(fmt.Sprintf("loukoum: named parameter %s is bound to conflicting values", name))
+		}
+		ctx.writePlaceholder(idx)
+		return
+	}
+
+	ctx.append(value)
+	ctx.named[name] = len(ctx.values)
+	ctx.writePlaceholder(len(ctx.values))
+}
+
+func (ctx *StdContext) writePlaceholder(idx int) {
 	ctx.Write(fmt.Sprintf("$%d", idx))
 }
 
@@ -75,3 +230,9 @@ func (ctx *StdContext) Bind(value interface{}) {
 func (ctx *StdContext) Values() []interface{} {
 	return ctx.values
 }
+
+// Ensure that NamedContext and StdContext are NamedBinder
+var (
+	_ NamedBinder = &NamedContext{}
+	_ NamedBinder = &StdContext{}
+)
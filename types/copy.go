@@ -0,0 +1,18 @@
+package types
+
+// CopyFormat represents the data format used by a COPY statement.
+type CopyFormat string
+
+func (e CopyFormat) String() string {
+	return string(e)
+}
+
+// CopyFormat types.
+const (
+	// CopyText has a "text" format.
+	CopyText = CopyFormat("text")
+	// CopyCSV has a "csv" format.
+	CopyCSV = CopyFormat("csv")
+	// CopyBinary has a "binary" format.
+	CopyBinary = CopyFormat("binary")
+)
@@ -0,0 +1,20 @@
+package types
+
+// ExplainFormat represents the output format of an EXPLAIN statement.
+type ExplainFormat string
+
+func (e ExplainFormat) String() string {
+	return string(e)
+}
+
+// ExplainFormat types.
+const (
+	// ExplainText has a "TEXT" format.
+	ExplainText = ExplainFormat("TEXT")
+	// ExplainJSON has a "JSON" format.
+	ExplainJSON = ExplainFormat("JSON")
+	// ExplainXML has a "XML" format.
+	ExplainXML = ExplainFormat("XML")
+	// ExplainYAML has a "YAML" format.
+	ExplainYAML = ExplainFormat("YAML")
+)
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"strings"
+)
+
 // JoinType represents a join type.
 type JoinType string
 
@@ -7,6 +11,11 @@ func (e JoinType) String() string {
 	return string(e)
 }
 
+// RequiresCondition returns true if join type expects a ON or a USING clause.
+func (e JoinType) RequiresCondition() bool {
+	return e != CrossJoin && !strings.HasPrefix(string(e), "NATURAL ")
+}
+
 // Join types.
 const (
 	// InnerJoin has a "INNER JOIN" type.
@@ -19,4 +28,18 @@ const (
 	LeftOuterJoin = JoinType("LEFT OUTER JOIN")
 	// RightOuterJoin has a "RIGHT OUTER JOIN" type.
 	RightOuterJoin = JoinType("RIGHT OUTER JOIN")
+	// FullJoin has a "FULL JOIN" type.
+	FullJoin = JoinType("FULL JOIN")
+	// FullOuterJoin has a "FULL OUTER JOIN" type.
+	FullOuterJoin = JoinType("FULL OUTER JOIN")
+	// CrossJoin has a "CROSS JOIN" type.
+	CrossJoin = JoinType("CROSS JOIN")
+	// NaturalJoin has a "NATURAL JOIN" type.
+	NaturalJoin = JoinType("NATURAL JOIN")
+	// NaturalLeftJoin has a "NATURAL LEFT JOIN" type.
+	NaturalLeftJoin = JoinType("NATURAL LEFT JOIN")
+	// NaturalRightJoin has a "NATURAL RIGHT JOIN" type.
+	NaturalRightJoin = JoinType("NATURAL RIGHT JOIN")
+	// NaturalFullJoin has a "NATURAL FULL JOIN" type.
+	NaturalFullJoin = JoinType("NATURAL FULL JOIN")
 )
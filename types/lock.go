@@ -0,0 +1,28 @@
+package types
+
+// LockMode represents the mode of a table-level lock.
+type LockMode string
+
+func (e LockMode) String() string {
+	return string(e)
+}
+
+// LockMode types.
+const (
+	// AccessShareLock has a "ACCESS SHARE" mode.
+	AccessShareLock = LockMode("ACCESS SHARE")
+	// RowShareLock has a "ROW SHARE" mode.
+	RowShareLock = LockMode("ROW SHARE")
+	// RowExclusiveLock has a "ROW EXCLUSIVE" mode.
+	RowExclusiveLock = LockMode("ROW EXCLUSIVE")
+	// ShareUpdateExclusiveLock has a "SHARE UPDATE EXCLUSIVE" mode.
+	ShareUpdateExclusiveLock = LockMode("SHARE UPDATE EXCLUSIVE")
+	// ShareLock has a "SHARE" mode.
+	ShareLock = LockMode("SHARE")
+	// ShareRowExclusiveLock has a "SHARE ROW EXCLUSIVE" mode.
+	ShareRowExclusiveLock = LockMode("SHARE ROW EXCLUSIVE")
+	// ExclusiveLock has a "EXCLUSIVE" mode.
+	ExclusiveLock = LockMode("EXCLUSIVE")
+	// AccessExclusiveLock has a "ACCESS EXCLUSIVE" mode.
+	AccessExclusiveLock = LockMode("ACCESS EXCLUSIVE")
+)
@@ -37,6 +37,12 @@ const (
 	NotLike            = ComparisonOperator("NOT LIKE")
 	ILike              = ComparisonOperator("ILIKE")
 	NotILike           = ComparisonOperator("NOT ILIKE")
+	SimilarTo          = ComparisonOperator("SIMILAR TO")
+	NotSimilarTo       = ComparisonOperator("NOT SIMILAR TO")
+	RegexMatch         = ComparisonOperator("~")
+	RegexIMatch        = ComparisonOperator("~*")
+	NotRegexMatch      = ComparisonOperator("!~")
+	NotRegexIMatch     = ComparisonOperator("!~*")
 	Between            = ComparisonOperator("BETWEEN")
 	NotBetween         = ComparisonOperator("NOT BETWEEN")
 	IsDistinctFrom     = ComparisonOperator("IS DISTINCT FROM")
@@ -45,3 +51,48 @@ const (
 	IsContainedBy      = ComparisonOperator("<@")
 	Overlap            = ComparisonOperator("&&")
 )
+
+// JSON operators.
+const (
+	JSONExtract         = ComparisonOperator("->")
+	JSONExtractText     = ComparisonOperator("->>")
+	JSONExtractPath     = ComparisonOperator("#>")
+	JSONExtractPathText = ComparisonOperator("#>>")
+	JSONHasKey          = ComparisonOperator("?")
+	JSONHasAnyKeys      = ComparisonOperator("?|")
+	JSONHasAllKeys      = ComparisonOperator("?&")
+	JSONPathExists      = ComparisonOperator("@?")
+	JSONPathMatch       = ComparisonOperator("@@")
+)
+
+// Range operators.
+const (
+	StrictlyLeft   = ComparisonOperator("<<")
+	StrictlyRight  = ComparisonOperator(">>")
+	NotExtendRight = ComparisonOperator("&<")
+	NotExtendLeft  = ComparisonOperator("&>")
+	Adjacent       = ComparisonOperator("-|-")
+)
+
+// Text search operators.
+const (
+	TextSearchMatch = ComparisonOperator("@@")
+)
+
+// ArithmeticOperator represents an arithmetic operator.
+type ArithmeticOperator string
+
+func (e ArithmeticOperator) String() string {
+	return string(e)
+}
+
+// Arithmetic operators.
+const (
+	Plus     = ArithmeticOperator("+")
+	Minus    = ArithmeticOperator("-")
+	Multiply = ArithmeticOperator("*")
+	Divide   = ArithmeticOperator("/")
+	Modulo   = ArithmeticOperator("%")
+	Exponent = ArithmeticOperator("^")
+	Concat   = ArithmeticOperator("||")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Overriding represents which value takes precedence when inserting into an identity column.
+type Overriding string
+
+func (e Overriding) String() string {
+	return string(e)
+}
+
+// Overriding kinds.
+const (
+	// OverridingSystemValue has a "OVERRIDING SYSTEM VALUE" kind.
+	OverridingSystemValue = Overriding("OVERRIDING SYSTEM VALUE")
+	// OverridingUserValue has a "OVERRIDING USER VALUE" kind.
+	OverridingUserValue = Overriding("OVERRIDING USER VALUE")
+)
@@ -0,0 +1,35 @@
+package types
+
+// IsolationLevel represents the isolation level of a transaction.
+type IsolationLevel string
+
+func (e IsolationLevel) String() string {
+	return string(e)
+}
+
+// IsolationLevel types.
+const (
+	// ReadUncommitted has a "READ UNCOMMITTED" isolation level.
+	ReadUncommitted = IsolationLevel("READ UNCOMMITTED")
+	// ReadCommitted has a "READ COMMITTED" isolation level.
+	ReadCommitted = IsolationLevel("READ COMMITTED")
+	// RepeatableRead has a "REPEATABLE READ" isolation level.
+	RepeatableRead = IsolationLevel("REPEATABLE READ")
+	// Serializable has a "SERIALIZABLE" isolation level.
+	Serializable = IsolationLevel("SERIALIZABLE")
+)
+
+// AccessMode represents the access mode of a transaction.
+type AccessMode string
+
+func (e AccessMode) String() string {
+	return string(e)
+}
+
+// AccessMode types.
+const (
+	// ReadWrite has a "READ WRITE" access mode.
+	ReadWrite = AccessMode("READ WRITE")
+	// ReadOnly has a "READ ONLY" access mode.
+	ReadOnly = AccessMode("READ ONLY")
+)